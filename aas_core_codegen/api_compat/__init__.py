@@ -0,0 +1 @@
+"""Check the compatibility of the public API between two generations."""
@@ -0,0 +1,116 @@
+"""Check the compatibility of the public API between two generations."""
+
+import argparse
+import pathlib
+import sys
+from typing import TextIO, Optional
+
+import aas_core_codegen
+from aas_core_codegen import run
+from aas_core_codegen.csharp import public_api as csharp_public_api
+import aas_core_codegen.api_compat
+
+assert __doc__ == aas_core_codegen.api_compat.__doc__
+
+
+def _load(
+    path: pathlib.Path, stderr: TextIO
+) -> Optional[csharp_public_api.PublicApi]:
+    """Load the public API from ``path`` and report the errors, if any."""
+    try:
+        public_api = csharp_public_api.load(path.read_text(encoding="utf-8"))
+    except Exception as exception:
+        run.write_error_report(
+            message=f"Failed to read the public API from {path}",
+            errors=[str(exception)],
+            stderr=stderr,
+        )
+        return None
+
+    if public_api is None:
+        stderr.write(
+            f"The public API in {path} has been written in an incompatible format; "
+            f"please re-generate it with this version of aas-core-codegen\n"
+        )
+        return None
+
+    return public_api
+
+
+def execute(
+    old_path: pathlib.Path,
+    new_path: pathlib.Path,
+    allow_breaking: bool,
+    stdout: TextIO,
+    stderr: TextIO,
+) -> int:
+    """Compare the public API in ``old_path`` against the one in ``new_path``."""
+    old = _load(old_path, stderr)
+    if old is None:
+        return 1
+
+    new = _load(new_path, stderr)
+    if new is None:
+        return 1
+
+    changes = csharp_public_api.compare(old=old, new=new)
+
+    for change in changes:
+        prefix = "BREAKING " if change.is_breaking() else ""
+        stdout.write(f"{prefix}{change}\n")
+
+    breaking = [change for change in changes if change.is_breaking()]
+    if len(breaking) > 0 and not allow_breaking:
+        stderr.write(
+            f"The public API has {len(breaking)} breaking change(s) "
+            f"between {old_path} and {new_path}\n"
+        )
+        return 1
+
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument(
+        "--old",
+        help="path to public_api.json of the previous generation",
+        required=True,
+    )
+    parser.add_argument(
+        "--new", help="path to public_api.json of the new generation", required=True
+    )
+    parser.add_argument(
+        "--allow_breaking",
+        help="report the breaking changes, but do not fail on them",
+        action="store_true",
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    return execute(
+        old_path=pathlib.Path(args.old),
+        new_path=pathlib.Path(args.new),
+        allow_breaking=bool(args.allow_breaking),
+        stdout=sys.stdout,
+        stderr=sys.stderr,
+    )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-api-compat")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-api-compat"))
@@ -0,0 +1 @@
+"""Cross-check the generated schemas and the SDK verification against each other."""
@@ -0,0 +1,563 @@
+"""Cross-check the generated schemas and the SDK verification against each other."""
+
+import argparse
+import collections
+import json
+import pathlib
+import re
+import sys
+import xml.etree.ElementTree as ET
+from typing import (
+    TextIO,
+    List,
+    Mapping,
+    MutableMapping,
+    Optional,
+    Tuple,
+    Any,
+    Callable,
+    Set,
+)
+
+import aas_core_codegen
+from aas_core_codegen import naming, parse, run, intermediate, infer_for_schema
+from aas_core_codegen.common import LinenoColumner, Identifier
+from aas_core_codegen.csharp import naming as csharp_naming
+from aas_core_codegen.rdf_shacl import naming as rdf_shacl_naming
+from aas_core_codegen.xsd import naming as xsd_naming
+import aas_core_codegen.consistency
+
+assert __doc__ == aas_core_codegen.consistency.__doc__
+
+#: Identify a property by its class name and its name as written in an artifact
+_Key = Tuple[str, str]
+
+#: Map the properties in an artifact to the number of their pattern constraints
+_Observation = Mapping[_Key, int]
+
+#: Render a cell of the coverage matrix for a property of a class
+_Cell = Callable[[intermediate.ClassUnion, Identifier], str]
+
+#: Map an identifier of the meta-model to its name in an artifact
+_Naming = Callable[[Identifier], Identifier]
+
+
+class _Expected:
+    """Represent a property as we expect it to appear in an artifact."""
+
+    def __init__(
+        self,
+        cls: intermediate.ClassUnion,
+        prop: intermediate.Property,
+        pattern_count: int,
+    ) -> None:
+        """Initialize with the given values."""
+        self.cls = cls
+        self.prop = prop
+        self.pattern_count = pattern_count
+
+
+def _expect(
+    symbol_table: intermediate.SymbolTable,
+    constraints_by_class: Mapping[
+        intermediate.ClassUnion, infer_for_schema.ConstraintsByProperty
+    ],
+    class_name: _Naming,
+    property_name: _Naming,
+) -> Mapping[_Key, _Expected]:
+    """Map the properties of the meta-model to their names in an artifact."""
+    result = collections.OrderedDict()  # type: MutableMapping[_Key, _Expected]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            continue
+
+        if our_type.is_implementation_specific:
+            continue
+
+        patterns_by_property = constraints_by_class[our_type].patterns_by_property
+
+        for prop in our_type.properties:
+            if prop.specified_for is not our_type:
+                continue
+
+            result[(class_name(our_type.name), property_name(prop.name))] = _Expected(
+                cls=our_type,
+                prop=prop,
+                pattern_count=len(patterns_by_property.get(prop, [])),
+            )
+
+    return result
+
+
+def _count_json_patterns(definition: Any) -> int:
+    """Count recursively the ``pattern`` keywords in the JSON ``definition``."""
+    if isinstance(definition, dict):
+        return sum(
+            (1 if key == "pattern" else 0) + _count_json_patterns(value)
+            for key, value in definition.items()
+        )
+
+    if isinstance(definition, list):
+        return sum(_count_json_patterns(item) for item in definition)
+
+    return 0
+
+
+def _observe_jsonschema(schema: Mapping[str, Any]) -> _Observation:
+    """Collect the properties and their patterns from the JSON ``schema``."""
+    result = collections.OrderedDict()  # type: MutableMapping[_Key, int]
+
+    for def_name, definition in schema.get("definitions", dict()).items():
+        if not isinstance(definition, dict):
+            continue
+
+        parts = [definition] + [
+            item for item in definition.get("allOf", []) if isinstance(item, dict)
+        ]
+
+        for part in parts:
+            for prop_name, prop_definition in part.get("properties", dict()).items():
+                # The model type is a serialization detail of the JSON schema and
+                # has no counterpart in the meta-model.
+                if prop_name == "modelType":
+                    continue
+
+                result[(def_name, prop_name)] = _count_json_patterns(prop_definition)
+
+    return result
+
+
+def _local_name(tag: str) -> str:
+    """Strip the namespace of the XML ``tag``."""
+    return tag.rsplit("}", 1)[-1]
+
+
+def _observe_xsd(root: ET.Element) -> _Observation:
+    """Collect the properties and their patterns from the XSD ``root``."""
+    result = collections.OrderedDict()  # type: MutableMapping[_Key, int]
+
+    for xs_group in root:
+        if _local_name(xs_group.tag) != "group" or "name" not in xs_group.attrib:
+            continue
+
+        for xs_sequence in xs_group:
+            if _local_name(xs_sequence.tag) != "sequence":
+                continue
+
+            for xs_element in xs_sequence:
+                if _local_name(xs_element.tag) != "element":
+                    continue
+
+                result[(xs_group.attrib["name"], xs_element.attrib["name"])] = sum(
+                    1
+                    for descendant in xs_element.iter()
+                    if _local_name(descendant.tag) == "pattern"
+                )
+
+    return result
+
+
+_SH_PATH_RE = re.compile(r"sh:path <[^>]*/([^/>]+)/([^/>]+)>")
+
+
+def _observe_shacl(text: str) -> _Observation:
+    """Collect the property shapes and their patterns from the SHACL ``text``."""
+    result = collections.OrderedDict()  # type: MutableMapping[_Key, int]
+
+    matches = list(_SH_PATH_RE.finditer(text))
+    for i, match in enumerate(matches):
+        end = matches[i + 1].start() if i + 1 < len(matches) else len(text)
+
+        result[(match.group(1), match.group(2))] = text.count(
+            "sh:pattern ", match.end(), end
+        )
+
+    return result
+
+
+class _Divergence:
+    """Represent a divergence between the meta-model and an artifact."""
+
+    def __init__(self, artifact: str, message: str) -> None:
+        """Initialize with the given values."""
+        self.artifact = artifact
+        self.message = message
+
+    def __str__(self) -> str:
+        return f"{self.artifact}: {self.message}"
+
+
+def _compare(
+    artifact: str,
+    expected: Mapping[_Key, _Expected],
+    observed: _Observation,
+    ignored_classes: Set[str],
+) -> List[_Divergence]:
+    """
+    Compare the ``observed`` properties of the ``artifact`` against the ``expected``.
+
+    The ``ignored_classes`` are given by the snippets, so we can not check them.
+    """
+    result = []  # type: List[_Divergence]
+
+    for key, expectation in expected.items():
+        pattern_count = observed.get(key, None)
+
+        if pattern_count is None:
+            result.append(
+                _Divergence(
+                    artifact,
+                    f"The property {expectation.prop.name!r} "
+                    f"of the class {expectation.cls.name!r} is missing; "
+                    f"expected it as {key[1]!r} in {key[0]!r}",
+                )
+            )
+        elif pattern_count != expectation.pattern_count:
+            result.append(
+                _Divergence(
+                    artifact,
+                    f"The property {expectation.prop.name!r} "
+                    f"of the class {expectation.cls.name!r} is constrained by "
+                    f"{pattern_count} pattern(s), "
+                    f"but expected {expectation.pattern_count}",
+                )
+            )
+
+    for key in observed:
+        if key not in expected and key[0] not in ignored_classes:
+            result.append(
+                _Divergence(
+                    artifact,
+                    f"The property {key[1]!r} of {key[0]!r} "
+                    f"does not correspond to any property in the meta-model",
+                )
+            )
+
+    return result
+
+
+_PUBLIC_STATIC_METHOD_RE = re.compile(r"\bpublic\s+static\s+\w+\s+(\w+)\s*\(")
+
+
+def _compare_csharp_verification(
+    symbol_table: intermediate.SymbolTable, code: str
+) -> List[_Divergence]:
+    """Check that all the verification functions appear in the generated C# code."""
+    defined = {match.group(1) for match in _PUBLIC_STATIC_METHOD_RE.finditer(code)}
+
+    result = []  # type: List[_Divergence]
+    for verification in symbol_table.verification_functions:
+        method_name = csharp_naming.method_name(verification.name)
+        if method_name not in defined:
+            result.append(
+                _Divergence(
+                    "C# verification",
+                    f"The verification function {verification.name!r} "
+                    f"is missing; expected it as {method_name!r}",
+                )
+            )
+
+    return result
+
+
+def _write_matrix(
+    symbol_table: intermediate.SymbolTable,
+    constraints_by_class: Mapping[
+        intermediate.ClassUnion, infer_for_schema.ConstraintsByProperty
+    ],
+    columns: List[Tuple[str, _Cell]],
+    stdout: TextIO,
+) -> None:
+    """
+    Write the constraint coverage matrix to ``stdout``.
+
+    Each cell gives the number of the patterns in the corresponding artifact.
+    Only the properties constrained by at least one pattern are listed.
+    """
+    header = ["Property", "Meta-model"] + [name for name, _ in columns]
+    rows = [header]  # type: List[List[str]]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            continue
+
+        if our_type.is_implementation_specific:
+            continue
+
+        patterns_by_property = constraints_by_class[our_type].patterns_by_property
+
+        for prop in our_type.properties:
+            if prop.specified_for is not our_type:
+                continue
+
+            pattern_count = len(patterns_by_property.get(prop, []))
+            if pattern_count == 0:
+                continue
+
+            rows.append(
+                [f"{our_type.name}.{prop.name}", str(pattern_count)]
+                + [cell(our_type, prop.name) for _, cell in columns]
+            )
+
+    widths = [max(len(row[i]) for row in rows) for i in range(len(header))]
+    for row in rows:
+        stdout.write(
+            "  ".join(value.ljust(width) for value, width in zip(row, widths)).rstrip()
+        )
+        stdout.write("\n")
+
+
+def execute(
+    model_path: pathlib.Path,
+    jsonschema_path: Optional[pathlib.Path],
+    xsd_path: Optional[pathlib.Path],
+    shacl_path: Optional[pathlib.Path],
+    csharp_verification_path: Optional[pathlib.Path],
+    stdout: TextIO,
+    stderr: TextIO,
+) -> int:
+    """Cross-check the given artifacts against the meta-model."""
+    text = model_path.read_text(encoding="utf-8-sig")
+
+    atok, parse_exception = parse.source_to_atok(source=text)
+    if parse_exception:
+        if isinstance(parse_exception, SyntaxError):
+            stderr.write(
+                f"Failed to parse the meta-model {model_path}: "
+                f"invalid syntax at line {parse_exception.lineno}\n"
+            )
+        else:
+            stderr.write(
+                f"Failed to parse the meta-model {model_path}: {parse_exception}\n"
+            )
+
+        return 1
+
+    import_errors = parse.check_expected_imports(atok=atok)
+    if import_errors:
+        run.write_error_report(
+            message="One or more unexpected imports in the meta-model",
+            errors=import_errors,
+            stderr=stderr,
+        )
+
+        return 1
+
+    lineno_columner = LinenoColumner(atok=atok)
+
+    parsed_symbol_table, error = parse.atok_to_symbol_table(atok=atok)
+    if error is not None:
+        run.write_error_report(
+            message=f"Failed to construct the symbol table from {model_path}",
+            errors=[lineno_columner.error_message(error)],
+            stderr=stderr,
+        )
+
+        return 1
+
+    assert parsed_symbol_table is not None
+
+    symbol_table, error = intermediate.translate(
+        parsed_symbol_table=parsed_symbol_table,
+        atok=atok,
+    )
+    if error is not None:
+        run.write_error_report(
+            message=f"Failed to translate the parsed symbol table "
+            f"to intermediate symbol table "
+            f"based on {model_path}",
+            errors=[lineno_columner.error_message(error)],
+            stderr=stderr,
+        )
+
+        return 1
+
+    assert symbol_table is not None
+
+    constraints_by_class, errors = infer_for_schema.infer_constraints_by_class(
+        symbol_table=symbol_table
+    )
+    if errors is not None:
+        run.write_error_report(
+            message=f"Failed to infer the constraints by class for the schemas "
+            f"based on {model_path}",
+            errors=[lineno_columner.error_message(error) for error in errors],
+            stderr=stderr,
+        )
+
+        return 1
+
+    assert constraints_by_class is not None
+
+    implementation_specific = [
+        our_type
+        for our_type in symbol_table.our_types
+        if isinstance(our_type, intermediate.Class)
+        and our_type.is_implementation_specific
+    ]
+
+    divergences = []  # type: List[_Divergence]
+    columns = []  # type: List[Tuple[str, _Cell]]
+
+    artifacts = []  # type: List[Tuple[str, _Observation, _Naming, _Naming]]
+
+    try:
+        if jsonschema_path is not None:
+            artifacts.append(
+                (
+                    "JSON schema",
+                    _observe_jsonschema(
+                        json.loads(jsonschema_path.read_text(encoding="utf-8"))
+                    ),
+                    naming.json_model_type,
+                    naming.json_property,
+                )
+            )
+
+        if xsd_path is not None:
+            artifacts.append(
+                (
+                    "XSD",
+                    _observe_xsd(ET.parse(str(xsd_path)).getroot()),
+                    xsd_naming.group_name,
+                    naming.xml_property,
+                )
+            )
+
+        if shacl_path is not None:
+            artifacts.append(
+                (
+                    "SHACL",
+                    _observe_shacl(shacl_path.read_text(encoding="utf-8")),
+                    rdf_shacl_naming.class_name,
+                    rdf_shacl_naming.property_name,
+                )
+            )
+    except Exception as exception:
+        run.write_error_report(
+            message="Failed to read the generated artifacts",
+            errors=[str(exception)],
+            stderr=stderr,
+        )
+        return 1
+
+    for artifact, observation, class_name, property_name in artifacts:
+        expected = _expect(
+            symbol_table=symbol_table,
+            constraints_by_class=constraints_by_class,
+            class_name=class_name,
+            property_name=property_name,
+        )
+
+        divergences.extend(
+            _compare(
+                artifact=artifact,
+                expected=expected,
+                observed=observation,
+                ignored_classes={
+                    class_name(cls.name) for cls in implementation_specific
+                },
+            )
+        )
+
+        # We bind the loop variables as default arguments so that each column
+        # refers to its own artifact.
+        def cell(
+            cls: intermediate.ClassUnion,
+            prop_name: Identifier,
+            observation: _Observation = observation,
+            class_name: _Naming = class_name,
+            property_name: _Naming = property_name,
+        ) -> str:
+            pattern_count = observation.get(
+                (class_name(cls.name), property_name(prop_name)), None
+            )
+            return "-" if pattern_count is None else str(pattern_count)
+
+        columns.append((artifact, cell))
+
+    if csharp_verification_path is not None:
+        try:
+            code = csharp_verification_path.read_text(encoding="utf-8")
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to read the C# verification from "
+                f"{csharp_verification_path}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+        divergences.extend(
+            _compare_csharp_verification(symbol_table=symbol_table, code=code)
+        )
+
+    _write_matrix(
+        symbol_table=symbol_table,
+        constraints_by_class=constraints_by_class,
+        columns=columns,
+        stdout=stdout,
+    )
+
+    if len(divergences) > 0:
+        run.write_error_report(
+            message=f"The generated artifacts diverge from the meta-model {model_path}",
+            errors=[str(divergence) for divergence in divergences],
+            stderr=stderr,
+        )
+        return 1
+
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument("--model_path", help="path to the meta-model", required=True)
+    parser.add_argument("--jsonschema", help="path to the generated JSON schema")
+    parser.add_argument("--xsd", help="path to the generated XSD")
+    parser.add_argument("--shacl", help="path to the generated SHACL schema")
+    parser.add_argument(
+        "--csharp_verification", help="path to the generated Verification.cs"
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    return execute(
+        model_path=pathlib.Path(args.model_path),
+        jsonschema_path=(
+            pathlib.Path(args.jsonschema) if args.jsonschema is not None else None
+        ),
+        xsd_path=pathlib.Path(args.xsd) if args.xsd is not None else None,
+        shacl_path=pathlib.Path(args.shacl) if args.shacl is not None else None,
+        csharp_verification_path=(
+            pathlib.Path(args.csharp_verification)
+            if args.csharp_verification is not None
+            else None
+        ),
+        stdout=sys.stdout,
+        stderr=sys.stderr,
+    )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-consistency")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-consistency"))
@@ -0,0 +1 @@
+"""Report which invariants of each class are enforced by which generated artifact."""
@@ -0,0 +1,492 @@
+"""Report which invariants of each class are enforced by which generated artifact."""
+import collections
+import json
+import re
+from typing import (
+    TextIO,
+    Any,
+    Dict,
+    Mapping,
+    MutableMapping,
+    List,
+    Optional,
+    Set,
+    Sequence,
+    Union,
+)
+
+import asttokens
+
+import aas_core_codegen.constraints_report
+from aas_core_codegen import intermediate, infer_for_schema, naming, run
+from aas_core_codegen.common import Identifier, Stripped
+from aas_core_codegen.csharp import naming as csharp_naming
+from aas_core_codegen.parse import tree as parse_tree
+from aas_core_codegen.rdf_shacl import naming as rdf_shacl_naming
+from aas_core_codegen.xsd import naming as xsd_naming
+
+assert aas_core_codegen.constraints_report.__doc__ == __doc__
+
+# We bump this version whenever the structure of the report changes so that
+# the downstream tools can check for compatibility.
+_FORMAT_VERSION = 2
+
+_JsonNode = MutableMapping[str, Any]
+
+#: The invariant is transpiled to the native code or to a schema construct.
+TRANSPILED = "transpiled"
+
+#: The invariant relies on an implementation-specific snippet.
+SNIPPET = "snippet"
+
+#: The invariant can not be represented in the schema and is left out.
+DROPPED_PER_SCHEMA = "dropped-per-schema"
+
+#: Map the targets with the schemas to their human-readable names
+_SCHEMA_TARGETS = collections.OrderedDict(
+    [("jsonschema", "JSON Schema"), ("xsd", "XSD"), ("rdf_shacl", "SHACL")]
+)  # type: MutableMapping[str, str]
+
+_ClassWithInvariants = Union[
+    intermediate.ConstrainedPrimitive,
+    intermediate.AbstractClass,
+    intermediate.ConcreteClass,
+]
+
+
+class _FunctionCallCollector(parse_tree.Visitor):
+    """Collect the names of all the called functions."""
+
+    def __init__(self) -> None:
+        """Initialize with no names collected."""
+        self.names = set()  # type: Set[Identifier]
+
+    def visit_function_call(self, node: parse_tree.FunctionCall) -> None:
+        """Remember the function name and descend into the arguments."""
+        self.names.add(node.name.identifier)
+        super().visit_function_call(node)
+
+
+def _called_functions(nodes: Sequence[parse_tree.Node]) -> Set[Identifier]:
+    """Collect the names of all the functions called in ``nodes``."""
+    collector = _FunctionCallCollector()
+    for node in nodes:
+        collector.visit(node)
+
+    return collector.names
+
+
+def _snippets_by_verification(
+    symbol_table: intermediate.SymbolTable,
+) -> Mapping[Identifier, Set[Identifier]]:
+    """
+    Map the verification functions to the snippets they rely on.
+
+    The implementation-specific verification functions come directly from
+    the snippets, while the transpilable ones rely on snippets if they call, directly
+    or indirectly, an implementation-specific verification function.
+    """
+    result = dict()  # type: Dict[Identifier, Set[Identifier]]
+    for verification in symbol_table.verification_functions:
+        result[verification.name] = (
+            {verification.name}
+            if isinstance(verification, intermediate.ImplementationSpecificVerification)
+            else set()
+        )
+
+    calls_by_name = {
+        verification.name: _called_functions(verification.parsed.body)
+        for verification in symbol_table.verification_functions
+        if isinstance(verification, intermediate.TranspilableVerification)
+    }
+
+    # We propagate until the fix point since the verification functions can call
+    # each other in arbitrary order.
+    changed = True
+    while changed:
+        changed = False
+        for name, calls in calls_by_name.items():
+            for call in calls:
+                if call == name or call not in result:
+                    continue
+
+                if not result[call].issubset(result[name]):
+                    result[name].update(result[call])
+                    changed = True
+
+    return result
+
+
+def _report_invariant(
+    invariant: intermediate.Invariant,
+    our_type: _ClassWithInvariants,
+    snippets_by_verification: Mapping[Identifier, Set[Identifier]],
+    pattern_verifications_by_name: infer_for_schema.PatternVerificationsByName,
+    atok: asttokens.ASTTokens,
+) -> _JsonNode:
+    """Report the status of the ``invariant`` for every target."""
+    snippets = set()  # type: Set[Identifier]
+    for name in _called_functions([invariant.body]):
+        snippets.update(snippets_by_verification.get(name, set()))
+
+    status_by_target = collections.OrderedDict()  # type: _JsonNode
+
+    status_by_target["csharp"] = SNIPPET if len(snippets) > 0 else TRANSPILED
+
+    schema_status = (
+        TRANSPILED
+        if infer_for_schema.is_enforced_by_schema(
+            invariant=invariant,
+            pattern_verifications_by_name=pattern_verifications_by_name,
+        )
+        else DROPPED_PER_SCHEMA
+    )
+    for target in _SCHEMA_TARGETS:
+        status_by_target[target] = schema_status
+
+    return collections.OrderedDict(
+        [
+            ("description", invariant.description),
+            ("specifiedFor", invariant.specified_for.name),
+            ("inherited", invariant.specified_for is not our_type),
+            ("source", atok.get_text(invariant.parsed.node)),
+            ("statusByTarget", status_by_target),
+            ("snippets", sorted(snippets)),
+        ]
+    )
+
+
+def generate_report(
+    symbol_table: intermediate.SymbolTable, atok: asttokens.ASTTokens
+) -> _JsonNode:
+    """Generate the report of the constraints by class."""
+    snippets_by_verification = _snippets_by_verification(symbol_table)
+
+    pattern_verifications_by_name = infer_for_schema.map_pattern_verifications_by_name(
+        verifications=symbol_table.verification_functions
+    )
+
+    classes = []  # type: List[_JsonNode]
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type,
+            (
+                intermediate.ConstrainedPrimitive,
+                intermediate.AbstractClass,
+                intermediate.ConcreteClass,
+            ),
+        ):
+            continue
+
+        classes.append(
+            collections.OrderedDict(
+                [
+                    ("name", our_type.name),
+                    (
+                        "invariants",
+                        [
+                            _report_invariant(
+                                invariant=invariant,
+                                our_type=our_type,
+                                snippets_by_verification=snippets_by_verification,
+                                pattern_verifications_by_name=(
+                                    pattern_verifications_by_name
+                                ),
+                                atok=atok,
+                            )
+                            for invariant in our_type.invariants
+                        ],
+                    ),
+                ]
+            )
+        )
+
+    report = collections.OrderedDict()  # type: _JsonNode
+    report["formatVersion"] = _FORMAT_VERSION
+    report["classes"] = classes
+    return report
+
+
+def _escape_in_table(text: str) -> str:
+    """Escape the ``text`` so that it can be put in a cell of a Markdown table."""
+    return " ".join(text.split()).replace("|", "\\|")
+
+
+def _render_markdown(report: _JsonNode) -> Stripped:
+    """Render the ``report`` as Markdown so that it can be read at a glance."""
+    header = ["Invariant", "Specified for", "C#"] + list(_SCHEMA_TARGETS.values())
+
+    blocks = ["# Constraints by class"]  # type: List[str]
+    for cls in report["classes"]:
+        if len(cls["invariants"]) == 0:
+            continue
+
+        lines = [
+            f"## {cls['name']}",
+            "",
+            "| " + " | ".join(header) + " |",
+            "|" + "|".join("---" for _ in header) + "|",
+        ]
+
+        for invariant in cls["invariants"]:
+            text = (
+                invariant["description"]
+                if invariant["description"] is not None
+                else f"`{invariant['source']}`"
+            )
+
+            specified_for = invariant["specifiedFor"]
+            if invariant["inherited"]:
+                specified_for += " (inherited)"
+
+            cells = [_escape_in_table(text), specified_for] + list(
+                invariant["statusByTarget"].values()
+            )
+            lines.append("| " + " | ".join(cells) + " |")
+
+        blocks.append("\n".join(lines))
+
+    return Stripped("\n\n".join(blocks))
+
+
+#: Match the constraint identifier at the start of an invariant description such as
+#: ``Constraint AASd-002: ...``
+_CONSTRAINT_IN_DESCRIPTION_RE = re.compile(r"^Constraint\s+(?P<identifier>[^:\s]+):")
+
+
+def constraint_identifier(description: Optional[str]) -> Optional[str]:
+    """Extract the constraint identifier from an invariant ``description``, if any."""
+    if description is None:
+        return None
+
+    mtch = _CONSTRAINT_IN_DESCRIPTION_RE.match(description)
+    if mtch is None:
+        return None
+
+    return mtch.group("identifier")
+
+
+def _declared_constraint_identifiers(
+    symbol_table: intermediate.SymbolTable,
+) -> List[str]:
+    """List the identifiers of the constraints declared in the descriptions."""
+    descriptions = [
+        symbol_table.meta_model.description
+    ]  # type: List[Optional[intermediate.SummaryRemarksConstraintsDescription]]
+
+    for our_type in symbol_table.our_types:
+        descriptions.append(our_type.description)
+
+        if isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            descriptions.extend(
+                prop.description
+                for prop in our_type.properties
+                if prop.specified_for is our_type
+            )
+
+    result = []  # type: List[str]
+    for description in descriptions:
+        if description is not None:
+            result.extend(description.constraints_by_identifier)
+
+    return result
+
+
+def _uses_constrained_primitive(
+    cls: intermediate.Class,
+    constrained_primitive: intermediate.ConstrainedPrimitive,
+) -> bool:
+    """Check whether an own property of ``cls`` is the ``constrained_primitive``."""
+    for prop in cls.properties:
+        if prop.specified_for is not cls:
+            continue
+
+        type_anno = intermediate.beneath_optional(prop.type_annotation)
+        if (
+            isinstance(type_anno, intermediate.OurTypeAnnotation)
+            and type_anno.our_type is constrained_primitive
+        ):
+            return True
+
+    return False
+
+
+def _schema_constructs(
+    invariant: intermediate.Invariant, symbol_table: intermediate.SymbolTable
+) -> List[_JsonNode]:
+    """
+    List the schema constructs which enforce the ``invariant``.
+
+    The constrained primitives are in-lined in the schemas, so their invariants are
+    enforced by the classes whose properties are constrained by them.
+    """
+    if isinstance(invariant.specified_for, intermediate.ConstrainedPrimitive):
+        classes = [
+            our_type
+            for our_type in symbol_table.our_types
+            if isinstance(
+                our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+            )
+            and _uses_constrained_primitive(our_type, invariant.specified_for)
+        ]  # type: List[intermediate.Class]
+    else:
+        classes = [invariant.specified_for]
+
+    constructs = []  # type: List[_JsonNode]
+    for cls in classes:
+        shape_name = rdf_shacl_naming.class_name(Identifier(cls.name + "_shape"))
+
+        constructs.extend(
+            [
+                collections.OrderedDict(
+                    [
+                        ("target", "jsonschema"),
+                        ("file", "schema.json"),
+                        (
+                            "symbol",
+                            f"#/definitions/{naming.json_model_type(cls.name)}",
+                        ),
+                    ]
+                ),
+                collections.OrderedDict(
+                    [
+                        ("target", "xsd"),
+                        ("file", "schema.xsd"),
+                        (
+                            "symbol",
+                            f"xs:group[@name='{xsd_naming.group_name(cls.name)}']",
+                        ),
+                    ]
+                ),
+                collections.OrderedDict(
+                    [
+                        ("target", "rdf_shacl"),
+                        ("file", "shacl-schema.ttl"),
+                        ("symbol", f"aas:{shape_name}"),
+                    ]
+                ),
+            ]
+        )
+
+    return constructs
+
+
+def _csharp_constructs(
+    invariant: intermediate.Invariant, symbol_table: intermediate.SymbolTable
+) -> List[_JsonNode]:
+    """
+    List the C# methods which verify the ``invariant``.
+
+    The invariants are stacked over the ancestors, so an invariant of a class is
+    verified in the transformations of all its concrete descendants.
+    """
+    if isinstance(invariant.specified_for, intermediate.ConstrainedPrimitive):
+        name = csharp_naming.class_name(invariant.specified_for.name)
+        symbols = [f"Verification.Verify{name}"]
+    else:
+        symbols = [
+            f"Verification.Transformer.Transform("
+            f"Aas.{csharp_naming.class_name(cls.name)})"
+            for cls in symbol_table.our_types
+            if isinstance(cls, intermediate.ConcreteClass)
+            and any(
+                an_invariant.parsed is invariant.parsed
+                for an_invariant in cls.invariants
+            )
+        ]
+
+    return [
+        collections.OrderedDict(
+            [("target", "csharp"), ("file", "verification.cs"), ("symbol", symbol)]
+        )
+        for symbol in symbols
+    ]
+
+
+def generate_traceability(symbol_table: intermediate.SymbolTable) -> _JsonNode:
+    """
+    Map the constraint identifiers to the generated constructs which enforce them.
+
+    The invariants refer to the constraints by the prefix ``Constraint {identifier}:``
+    in their descriptions. We list all the declared constraints so that
+    the constraints without any invariant are visible as well.
+    """
+    pattern_verifications_by_name = infer_for_schema.map_pattern_verifications_by_name(
+        verifications=symbol_table.verification_functions
+    )
+
+    constructs_by_identifier = collections.OrderedDict(
+        (identifier, [])
+        for identifier in _declared_constraint_identifiers(symbol_table)
+    )  # type: MutableMapping[str, List[_JsonNode]]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type,
+            (
+                intermediate.ConstrainedPrimitive,
+                intermediate.AbstractClass,
+                intermediate.ConcreteClass,
+            ),
+        ):
+            continue
+
+        for invariant in our_type.invariants:
+            if invariant.specified_for is not our_type:
+                continue
+
+            identifier = constraint_identifier(invariant.description)
+            if identifier is None:
+                continue
+
+            constructs = constructs_by_identifier.setdefault(identifier, [])
+
+            constructs.extend(_csharp_constructs(invariant, symbol_table))
+
+            if infer_for_schema.is_enforced_by_schema(
+                invariant=invariant,
+                pattern_verifications_by_name=pattern_verifications_by_name,
+            ):
+                constructs.extend(_schema_constructs(invariant, symbol_table))
+
+    traceability = collections.OrderedDict()  # type: _JsonNode
+    traceability["formatVersion"] = _FORMAT_VERSION
+    traceability["constructsByConstraint"] = collections.OrderedDict(
+        (identifier, constructs_by_identifier[identifier])
+        for identifier in sorted(constructs_by_identifier)
+    )
+    return traceability
+
+
+def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
+    """Generate the report of the constraints by class and the traceability matrix."""
+    report = generate_report(
+        symbol_table=context.symbol_table, atok=context.lineno_columner.atok
+    )
+
+    for name, text in [
+        ("constraints_by_class.json", json.dumps(report, indent=2)),
+        ("constraints_by_class.md", _render_markdown(report)),
+        (
+            "traceability.json",
+            json.dumps(generate_traceability(context.symbol_table), indent=2),
+        ),
+    ]:
+        pth = context.output_dir / name
+        try:
+            context.write_text(path=pth, text=text + "\n")
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the constraints report to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    context.logger.info(
+        f"Code generated to: {context.output_dir}",
+        output_dir=str(context.output_dir),
+    )
+    return 0
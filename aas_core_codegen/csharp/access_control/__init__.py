@@ -0,0 +1,4 @@
+"""Generate C# code for enforcing access control on instances before export."""
+from aas_core_codegen.csharp.access_control import _generate
+
+generate = _generate.generate
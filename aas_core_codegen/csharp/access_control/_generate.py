@@ -0,0 +1,279 @@
+"""Generate C# code for enforcing access control on instances before export."""
+
+import io
+import textwrap
+from typing import Tuple, Optional, List
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Error, Stripped, indent_but_first_line
+from aas_core_codegen.csharp import (
+    common as csharp_common,
+    redaction as csharp_redaction,
+)
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II
+
+
+def _collect_access_controlled_properties(
+    symbol_table: intermediate.SymbolTable,
+) -> Tuple[Optional[List[intermediate.Property]], Optional[List[Error]]]:
+    """
+    Collect the properties marked as access-controlled in the meta-model.
+
+    Only the optional properties can be access-controlled, since the required
+    ones can not be stripped without breaking the instances.
+    """
+    props = []  # type: List[intermediate.Property]
+    errors = []  # type: List[Error]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            continue
+
+        for prop in our_type.properties:
+            if prop.specified_for is not our_type or not prop.access_controlled:
+                continue
+
+            if not isinstance(
+                prop.type_annotation, intermediate.OptionalTypeAnnotation
+            ):
+                errors.append(
+                    Error(
+                        prop.parsed.node,
+                        f"The property {prop.name!r} of the class {our_type.name!r} "
+                        f"is marked as access-controlled, but it is required. "
+                        f"Only optional properties can be stripped from "
+                        f"the instances in the C# access control.",
+                    )
+                )
+                continue
+
+            props.append(prop)
+
+    if len(errors) > 0:
+        return None, errors
+
+    return props, None
+
+
+def _generate_property_enum(props: List[intermediate.Property]) -> Stripped:
+    """Generate the enumeration of the access-controlled properties."""
+    writer = io.StringIO()
+    writer.write(
+        """\
+/// <summary>
+/// Enumerate the properties marked as access-controlled in the meta-model.
+/// </summary>
+/// <remarks>
+/// The literals are named after the class which declares the property.
+/// </remarks>
+public enum Property
+{
+"""
+    )
+
+    for i, prop in enumerate(props):
+        if i > 0:
+            writer.write(",\n")
+        writer.write(f"{I}{csharp_redaction.property_literal(prop)}")
+
+    if len(props) > 0:
+        writer.write("\n")
+
+    writer.write("}  // public enum Property")
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_policy() -> Stripped:
+    """Generate the interface of the access-control callbacks."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Decide which instances and properties the current subject may read.
+/// </summary>
+/// <remarks>
+/// Implement this interface to plug in your attribute-based access control.
+/// </remarks>
+public interface IPolicy
+{{
+{I}/// <summary>
+{I}/// Check whether <paramref name="that" /> may be read at all.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// Only the instances contained in lists are checked, so that
+{I}/// the structure of the parent instances remains intact.
+{I}/// </remarks>
+{I}bool MayRead(Aas.IClass that);
+
+{I}/// <summary>
+{I}/// Check whether the access-controlled <paramref name="property" /> of
+{I}/// <paramref name="that" /> may be read.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// Only the properties which are set are checked.
+{I}/// </remarks>
+{I}bool MayRead(Aas.IClass that, Property property);
+}}  // public interface IPolicy"""
+    )
+
+
+def _generate_policy_filter(props: List[intermediate.Property]) -> Stripped:
+    """Generate the adapter of a policy to a redaction filter."""
+    if len(props) == 0:
+        strips_body = Stripped("return false;")
+    else:
+        writer = io.StringIO()
+        writer.write("switch (property)\n{\n")
+        for prop in props:
+            # The literals coincide with the ones in ``Redaction.Property``.
+            literal = csharp_redaction.property_literal(prop)
+            writer.write(
+                f"""\
+{I}case Redaction.Property.{literal}:
+{II}return !_policy.MayRead(that, Property.{literal});
+"""
+            )
+        writer.write(f"{I}default:\n{II}return false;\n}}")
+        strips_body = Stripped(writer.getvalue())
+
+    return Stripped(
+        f"""\
+/// <summary>
+/// Redact the instances according to a policy.
+/// </summary>
+/// <remarks>
+/// Only the access-controlled properties are checked against the policy.
+/// </remarks>
+private class PolicyFilter : Redaction.IFilter
+{{
+{I}private readonly IPolicy _policy;
+
+{I}public PolicyFilter(IPolicy policy)
+{I}{{
+{II}_policy = policy;
+{I}}}
+
+{I}public bool Strips(Aas.IClass that, Redaction.Property property)
+{I}{{
+{II}{indent_but_first_line(strips_body, II)}
+{I}}}
+
+{I}public bool Excludes(Aas.IClass that)
+{I}{{
+{II}return !_policy.MayRead(that);
+{I}}}
+}}  // private class PolicyFilter"""
+    )
+
+
+def _generate_enforce() -> Stripped:
+    """Generate the entry points for the enforcement."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Make a copy of <paramref name="that" /> and all its descendants redacted
+/// according to <paramref name="policy" />.
+/// </summary>
+/// <remarks>
+/// The original instances are left untouched. The enforced instances are
+/// not necessarily valid anymore, similar to <see cref="Redaction.Redact" />.
+/// </remarks>
+public static T Enforce<T>(T that, IPolicy policy) where T : Aas.IClass
+{{
+{I}return Redaction.Redact(that, new PolicyFilter(policy));
+}}
+
+/// <summary>
+/// Serialize <paramref name="that" /> to JSON with <paramref name="policy" />
+/// enforced.
+/// </summary>
+/// <remarks>
+/// The original instances are left untouched.
+/// </remarks>
+public static System.Text.Json.Nodes.JsonObject ToJsonObject(
+{I}Aas.IClass that,
+{I}IPolicy policy)
+{{
+{I}return Jsonization.Serialize.ToJsonObject(Enforce(that, policy));
+}}"""
+    )
+
+
+# fmt: off
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+@ensure(
+    lambda result:
+    not (result[0] is not None) or result[0].endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+) -> Tuple[Optional[str], Optional[List[Error]]]:
+    """
+    Generate the C# code for enforcing access control on the model instances.
+
+    The generated code relies on the redaction, so the redaction needs to be
+    generated as well.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    props, errors = _collect_access_controlled_properties(symbol_table=symbol_table)
+    if errors is not None:
+        return None, errors
+
+    assert props is not None
+
+    access_control_blocks = [
+        _generate_property_enum(props=props),
+        _generate_policy(),
+        _generate_policy_filter(props=props),
+        _generate_enforce(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Provide hooks for servers to enforce their access control on the data
+{I}/// before it leaves the server.
+{I}/// </summary>
+{I}public static class AccessControl
+{I}{{
+"""
+    )
+
+    for i, access_control_block in enumerate(access_control_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(access_control_block, II))
+
+    writer.write(f"\n{I}}}  // public static class AccessControl")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(f"using Aas = {namespace};"),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue(), None
@@ -0,0 +1,4 @@
+"""Generate C# code for canonicalizing the lexical values of XSD numbers."""
+from aas_core_codegen.csharp.canonicalization import _generate
+
+generate = _generate.generate
@@ -0,0 +1,198 @@
+"""Generate C# code for canonicalizing the lexical values of XSD numbers."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_to_canonical_form() -> Stripped:
+    """Generate the conversion of a round-trip representation to the canonical one."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Convert the round-trip representation <paramref name="roundTrip" /> of
+/// a finite non-zero number to the canonical XSD representation.
+/// </summary>
+/// <remarks>
+/// The canonical representation has a single non-zero digit before
+/// the decimal point, no trailing zeros in the fraction except for a single
+/// zero, and an exponent without a plus sign or leading zeros,
+/// e.g., <c>1.0E1</c>.
+/// </remarks>
+private static string ToCanonicalForm(string roundTrip)
+{{
+{I}bool negative = roundTrip.StartsWith("-");
+{I}string text = negative ? roundTrip.Substring(1) : roundTrip;
+
+{I}int exponent = 0;
+{I}int exponentStart = text.IndexOfAny(new[] {{ 'E', 'e' }});
+{I}if (exponentStart >= 0)
+{I}{{
+{II}exponent = int.Parse(
+{III}text.Substring(exponentStart + 1),
+{III}System.Globalization.NumberStyles.AllowLeadingSign,
+{III}System.Globalization.CultureInfo.InvariantCulture);
+{II}text = text.Substring(0, exponentStart);
+{I}}}
+
+{I}string digits;
+{I}int point = text.IndexOf('.');
+{I}if (point >= 0)
+{I}{{
+{II}digits = text.Substring(0, point) + text.Substring(point + 1);
+{II}exponent += point - 1;
+{I}}}
+{I}else
+{I}{{
+{II}digits = text;
+{II}exponent += text.Length - 1;
+{I}}}
+
+{I}int firstNonZero = 0;
+{I}while (digits[firstNonZero] == '0')
+{I}{{
+{II}firstNonZero++;
+{II}exponent--;
+{I}}}
+
+{I}digits = digits.Substring(firstNonZero).TrimEnd('0');
+
+{I}string fraction = digits.Length > 1 ? digits.Substring(1) : "0";
+{I}return $"{{(negative ? "-" : "")}}{{digits[0]}}.{{fraction}}E{{exponent}}";
+}}"""
+    )
+
+
+def _generate_canonicalize(xs_type: str, cs_type: str, function_name: str) -> Stripped:
+    """Generate the canonicalization of a lexical value of the ``xs_type``."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Canonicalize the lexical <paramref name="value" /> of <c>{xs_type}</c>,
+/// e.g., <c>+1e1</c> becomes <c>1.0E1</c>.
+/// </summary>
+/// <remarks>
+/// The <paramref name="value" /> is assumed to be already checked against
+/// the lexical space of <c>{xs_type}</c>. The values out of range are
+/// rounded to the infinities.
+/// </remarks>
+/// <returns>
+/// Canonical representation, or <c>null</c> if <paramref name="value" />
+/// could not be parsed
+/// </returns>
+public static string? {function_name}(string value)
+{{
+{I}switch (value)
+{I}{{
+{II}case "INF":
+{II}case "+INF":
+{III}return "INF";
+{II}case "-INF":
+{III}return "-INF";
+{II}case "NaN":
+{III}return "NaN";
+{I}}}
+
+{I}if (!{cs_type}.TryParse(
+{II}value,
+{II}System.Globalization.NumberStyles.Float,
+{II}System.Globalization.CultureInfo.InvariantCulture,
+{II}out {cs_type} parsed))
+{I}{{
+{II}return null;
+{I}}}
+
+{I}if ({cs_type}.IsPositiveInfinity(parsed))
+{I}{{
+{II}return "INF";
+{I}}}
+
+{I}if ({cs_type}.IsNegativeInfinity(parsed))
+{I}{{
+{II}return "-INF";
+{I}}}
+
+{I}if (parsed == 0)
+{I}{{
+{II}return value.StartsWith("-") ? "-0.0E0" : "0.0E0";
+{I}}}
+
+{I}return ToCanonicalForm(
+{II}parsed.ToString(
+{III}"R", System.Globalization.CultureInfo.InvariantCulture));
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(namespace: csharp_common.NamespaceIdentifier) -> str:
+    """
+    Generate the C# code for canonicalizing the lexical values of XSD numbers.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    canonicalization_blocks = [
+        _generate_to_canonical_form(),
+        _generate_canonicalize(
+            xs_type="xs:double",
+            cs_type="double",
+            function_name="CanonicalizeXsDouble",
+        ),
+        _generate_canonicalize(
+            xs_type="xs:float", cs_type="float", function_name="CanonicalizeXsFloat"
+        ),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Canonicalize the lexical values according to the XSD rules so that
+{I}/// the values coming from different SDKs can be compared as text.
+{I}/// </summary>
+{I}public static class Canonicalization
+{I}{{
+"""
+    )
+
+    for i, canonicalization_block in enumerate(canonicalization_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(canonicalization_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Canonicalization")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
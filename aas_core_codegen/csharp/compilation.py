@@ -0,0 +1,132 @@
+"""Compile the generated C# code as a smoke test of the generator."""
+import pathlib
+import re
+import shutil
+import subprocess
+import tempfile
+from typing import TextIO, List, Mapping, MutableMapping
+
+from aas_core_codegen import run
+
+#: Map the generated files to the modules which generate them
+MODULE_BY_FILE = {
+    "types.cs": "aas_core_codegen.csharp.structure",
+    "visitation.cs": "aas_core_codegen.csharp.visitation",
+    "constants.cs": "aas_core_codegen.csharp.constants",
+    "verification.cs": "aas_core_codegen.csharp.verification",
+    "reporting.cs": "aas_core_codegen.csharp.reporting",
+    "stringification.cs": "aas_core_codegen.csharp.stringification",
+    "jsonization.cs": "aas_core_codegen.csharp.jsonization",
+    "xmlization.cs": "aas_core_codegen.csharp.xmlization",
+    "digestion.cs": "aas_core_codegen.csharp.digestion",
+    "canonicalization.cs": "aas_core_codegen.csharp.canonicalization",
+    "language_tags.cs": "aas_core_codegen.csharp.language_tags",
+    "iri_validation.cs": "aas_core_codegen.csharp.iri_validation",
+    "json_lines.cs": "aas_core_codegen.csharp.json_lines",
+    "fuzzing.cs": "aas_core_codegen.csharp.fuzzing",
+    "signing.cs": "aas_core_codegen.csharp.signing",
+    "redaction.cs": "aas_core_codegen.csharp.redaction",
+    "access_control.cs": "aas_core_codegen.csharp.access_control",
+    "instrumentation.cs": "aas_core_codegen.csharp.instrumentation",
+    "statistics.cs": "aas_core_codegen.csharp.statistics",
+    "factories.cs": "aas_core_codegen.csharp.factories",
+}  # type: Mapping[str, str]
+
+# The generated code relies only on the standard library, so we compile it
+# without any package references. We enable all the opt-in modules so that
+# they are compiled as well.
+_PROJECT = """\
+<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <TargetFramework>net6.0</TargetFramework>
+    <OutputType>Library</OutputType>
+    <Nullable>enable</Nullable>
+    <LangVersion>10</LangVersion>
+    <EnableDefaultCompileItems>false</EnableDefaultCompileItems>
+    <DefineConstants>$(DefineConstants);AAS_CORE_INSTRUMENTATION</DefineConstants>
+  </PropertyGroup>
+  <ItemGroup>
+    <Compile Include="{output_dir}/*.cs" />
+  </ItemGroup>
+</Project>
+"""
+
+_DIAGNOSTIC_RE = re.compile(
+    r"^(?P<path>[^(\s][^(]*\.cs)\(\d+,\d+\): error (?P<code>CS\d+):.*$",
+    re.MULTILINE,
+)
+
+
+def _map_diagnostics(build_output: str) -> List[str]:
+    """Group the compilation errors in ``build_output`` by the generating module."""
+    diagnostics_by_module = dict()  # type: MutableMapping[str, List[str]]
+
+    for match in _DIAGNOSTIC_RE.finditer(build_output):
+        name = pathlib.Path(match.group("path")).name
+        module = MODULE_BY_FILE.get(name, f"the snippets or unknown module ({name})")
+
+        diagnostics = diagnostics_by_module.setdefault(module, [])
+
+        # MSBuild reports the same error twice, once during the build and once in
+        # the summary, so we skip the duplicates.
+        diagnostic = match.group(0).strip()
+        if diagnostic not in diagnostics:
+            diagnostics.append(diagnostic)
+
+    return [
+        f"Generated by {module}:\n" + "\n".join(diagnostics)
+        for module, diagnostics in diagnostics_by_module.items()
+    ]
+
+
+def smoke_compile(output_dir: pathlib.Path, logger: run.Logger, stderr: TextIO) -> int:
+    """
+    Compile the C# code generated to ``output_dir`` with ``dotnet build``.
+
+    If the .NET SDK is not available, we skip the compilation with a notice.
+    """
+    dotnet = shutil.which("dotnet")
+    if dotnet is None:
+        logger.info(
+            "The dotnet executable could not be found, "
+            "so we skip the smoke compilation of the C# code."
+        )
+        return 0
+
+    with tempfile.TemporaryDirectory() as tmp_dir:
+        project_pth = pathlib.Path(tmp_dir) / "SmokeCompile.csproj"
+        project_pth.write_text(
+            _PROJECT.format(output_dir=output_dir.resolve().as_posix()),
+            encoding="utf-8",
+        )
+
+        try:
+            completed = subprocess.run(
+                [dotnet, "build", str(project_pth), "-nologo"],
+                stdout=subprocess.PIPE,
+                stderr=subprocess.STDOUT,
+                encoding="utf-8",
+                check=False,
+            )
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to run {dotnet} to smoke-compile {output_dir}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    if completed.returncode != 0:
+        errors = _map_diagnostics(completed.stdout)
+        if len(errors) == 0:
+            errors = [completed.stdout.strip()]
+
+        run.write_error_report(
+            message=f"Failed to smoke-compile the C# code generated to {output_dir}",
+            errors=errors,
+            stderr=stderr,
+        )
+        return 1
+
+    logger.info(f"The C# code in {output_dir} compiled successfully.")
+    return 0
@@ -170,10 +170,14 @@ class _ElementRenderer(intermediate_rendering.DocutilsElementTransformer[_NodeUn
             name = csharp_naming.enum_name(element.our_type.name)
 
         elif isinstance(element.our_type, intermediate.ConstrainedPrimitive):
-            # NOTE (mristin, 2021-12-17):
-            # We do not generate a class for constrained primitives, but we
-            # leave it as class name, as that is what we used for ``Verify*`` function.
-            name = csharp_naming.class_name(element.our_type.name)
+            # We do not generate a class for constrained primitives so that
+            # a ``cref`` to the class name would be dangling. We refer to
+            # the corresponding ``Verify*`` function instead so that the link
+            # can be resolved by the documentation tools.
+            name = (
+                f"Verification.Verify"
+                f"{csharp_naming.class_name(element.our_type.name)}"
+            )
 
         elif isinstance(element.our_type, intermediate.Class):
             if isinstance(element.our_type, intermediate.AbstractClass):
@@ -323,6 +327,27 @@ class _ElementRenderer(intermediate_rendering.DocutilsElementTransformer[_NodeUn
             None,
         )
 
+    def transform_literal_block(
+        self, element: docutils.nodes.literal_block
+    ) -> Tuple[Optional[_NodeUnion], Optional[List[str]]]:
+        return (
+            _Element(
+                name="code", children=_List(items=[_Text(content=element.astext())])
+            ),
+            None,
+        )
+
+    def transform_example(
+        self, element: intermediate_doc.Example
+    ) -> Tuple[Optional[_NodeUnion], Optional[List[str]]]:
+        children, errors = self._transform_children_of(element)
+        if errors is not None:
+            return None, errors
+
+        assert children is not None
+
+        return _Element(name="example", children=children), None
+
     def _transform_children_of(
         self,
         element: docutils.nodes.Element,
@@ -403,7 +428,26 @@ class _ElementRenderer(intermediate_rendering.DocutilsElementTransformer[_NodeUn
     def transform_reference(
         self, element: docutils.nodes.reference
     ) -> Tuple[Optional[_NodeUnion], Optional[List[str]]]:
-        return self._transform_children_of(element)
+        children, errors = self._transform_children_of(element)
+        if errors is not None:
+            return None, errors
+
+        assert children is not None
+
+        refuri = element.get("refuri", None)
+        if refuri is None:
+            # We can not link to anything meaningful without an URI, so we
+            # render only the text of the reference.
+            return children, None
+
+        return (
+            _Element(
+                name="a",
+                attrs=collections.OrderedDict([("href", refuri)]),
+                children=children,
+            ),
+            None,
+        )
 
     def transform_field_body(
         self, element: docutils.nodes.field_body
@@ -480,7 +524,8 @@ class _RemoveRedundantParaVisitor(_NodeVisitor):
 
         # noinspection PyUnresolvedReferences
         if (
-            node.name in ("summary", "remarks", "li", "param", "returns", "para")
+            node.name
+            in ("summary", "remarks", "example", "li", "param", "returns", "para")
             and len(node.children.items) == 1
             and isinstance(node.children.items[0], _Element)
             and node.children.items[0].name == "para"
@@ -523,6 +568,7 @@ def _render_summary_remarks(
         )
 
     remark_nodes = []  # type: List[_NodeUnion]
+    example_nodes = []  # type: List[_NodeUnion]
     for remark in description.remarks:
         remark_node, remark_errors = element_renderer.transform(remark)
         if remark_errors:
@@ -531,7 +577,13 @@ def _render_summary_remarks(
             )
         else:
             assert remark_node is not None
-            remark_nodes.append(remark_node)
+
+            # The examples are top-level elements in C# documentation comments,
+            # so we pull them out of the remarks.
+            if isinstance(remark, intermediate_doc.Example):
+                example_nodes.append(remark_node)
+            else:
+                remark_nodes.append(remark_node)
 
     if len(errors) > 0:
         return None, errors
@@ -541,6 +593,8 @@ def _render_summary_remarks(
             _Element(name="remarks", children=_List(items=remark_nodes))
         )
 
+    result_items.extend(example_nodes)
+
     return _List(items=result_items), None
 
 
@@ -565,6 +619,7 @@ def _render_summary_remarks_constraints(
         )
 
     remark_nodes = []  # type: List[_NodeUnion]
+    example_nodes = []  # type: List[_NodeUnion]
     for remark in description.remarks:
         remark_node, remark_errors = element_renderer.transform(remark)
         if remark_errors:
@@ -573,7 +628,13 @@ def _render_summary_remarks_constraints(
             )
         else:
             assert remark_node is not None
-            remark_nodes.append(remark_node)
+
+            # The examples are top-level elements in C# documentation comments,
+            # so we pull them out of the remarks.
+            if isinstance(remark, intermediate_doc.Example):
+                example_nodes.append(remark_node)
+            else:
+                remark_nodes.append(remark_node)
 
     constraint_nodes = []  # type: List[_NodeUnion]
     for identifier, docutils_element in description.constraints_by_identifier.items():
@@ -631,11 +692,23 @@ def _render_summary_remarks_constraints(
 
         remark_nodes.append(ul_node)
 
+    if description.since is not None:
+        remark_nodes.append(
+            _Element(
+                name="para",
+                children=_List(
+                    items=[_Text(content=f"Available since {description.since}.")]
+                ),
+            )
+        )
+
     if len(remark_nodes) > 0:
         result_items.append(
             _Element(name="remarks", children=_List(items=remark_nodes))
         )
 
+    result_items.extend(example_nodes)
+
     return _List(items=result_items), None
 
 
@@ -725,7 +798,15 @@ class _ToTextDirectivesVisitor(_NodeVisitor):
 
     def visit_element(self, node: _Element) -> None:
         """Visit the element node and its children."""
-        if node.name in ("summary", "remarks", "para", "param", "returns"):
+        if node.name in (
+            "summary",
+            "remarks",
+            "example",
+            "code",
+            "para",
+            "param",
+            "returns",
+        ):
             # NOTE (mristin, 2022-07-18):
             # We render these tags without indention for better readability.
 
@@ -1025,6 +1106,7 @@ def _render_description_of_signature(
         )
 
     remark_nodes = []  # type: List[_NodeUnion]
+    example_nodes = []  # type: List[_NodeUnion]
     for remark in description.remarks:
         remark_node, remark_errors = renderer.transform(remark)
         if remark_errors:
@@ -1033,7 +1115,11 @@ def _render_description_of_signature(
             )
         else:
             assert remark_node is not None
-            remark_nodes.append(remark_node)
+
+            if isinstance(remark, intermediate_doc.Example):
+                example_nodes.append(remark_node)
+            else:
+                remark_nodes.append(remark_node)
 
     param_nodes = []  # type: List[_NodeUnion]
 
@@ -1084,6 +1170,8 @@ def _render_description_of_signature(
     if returns_node is not None:
         result_items.append(returns_node)
 
+    result_items.extend(example_nodes)
+
     return _List(items=result_items), None
 
 
@@ -0,0 +1,4 @@
+"""Generate C# code for computing stable digests of model instances."""
+from aas_core_codegen.csharp.digestion import _generate
+
+generate = _generate.generate
@@ -0,0 +1,489 @@
+"""Generate C# code for computing stable digests of model instances."""
+
+import io
+import textwrap
+from typing import Tuple, Optional, List
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate, specific_implementations
+from aas_core_codegen.common import Error, Stripped, Identifier, IDENTIFIER_RE
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_write_canonical_string() -> Stripped:
+    """Generate the function which writes a JSON string in the canonical form."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Write <paramref name="text" /> as a canonical JSON string
+/// to <paramref name="builder" />.
+/// </summary>
+/// <remarks>
+/// Only the quotation mark, the backslash and the control characters are
+/// escaped as required by RFC 8785. All the other characters, including
+/// the non-ASCII ones, are written as-is.
+/// </remarks>
+private static void WriteCanonicalString(
+{I}string text,
+{I}System.Text.StringBuilder builder)
+{{
+{I}builder.Append('"');
+{I}foreach (char character in text)
+{I}{{
+{II}switch (character)
+{II}{{
+{III}case '"':
+{III}{I}builder.Append("\\\\\\"");
+{III}{I}break;
+{III}case '\\\\':
+{III}{I}builder.Append("\\\\\\\\");
+{III}{I}break;
+{III}case '\\b':
+{III}{I}builder.Append("\\\\b");
+{III}{I}break;
+{III}case '\\f':
+{III}{I}builder.Append("\\\\f");
+{III}{I}break;
+{III}case '\\n':
+{III}{I}builder.Append("\\\\n");
+{III}{I}break;
+{III}case '\\r':
+{III}{I}builder.Append("\\\\r");
+{III}{I}break;
+{III}case '\\t':
+{III}{I}builder.Append("\\\\t");
+{III}{I}break;
+{III}default:
+{III}{I}if (character < 0x20)
+{III}{I}{{
+{III}{II}builder.Append("\\\\u");
+{III}{II}builder.Append(((int)character).ToString("x4"));
+{III}{I}}}
+{III}{I}else
+{III}{I}{{
+{III}{II}builder.Append(character);
+{III}{I}}}
+{III}{I}break;
+{II}}}
+{I}}}
+{I}builder.Append('"');
+}}"""
+    )
+
+
+def _generate_write_canonical_number() -> Stripped:
+    """Generate the function which writes a JSON number in the canonical form."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Write <paramref name="number" /> as a canonical JSON number
+/// to <paramref name="builder" />.
+/// </summary>
+/// <remarks>
+/// The number is formatted as in ECMAScript (<c>Number.prototype.toString</c>)
+/// as required by RFC 8785, e.g., <c>1e+21</c> or <c>1.5e-7</c>.
+/// </remarks>
+/// <exception cref="System.ArgumentException">
+/// Thrown when <paramref name="number" /> is not finite.
+/// </exception>
+private static void WriteCanonicalNumber(
+{I}double number,
+{I}System.Text.StringBuilder builder)
+{{
+{I}if (double.IsNaN(number) || double.IsInfinity(number))
+{I}{{
+{II}throw new System.ArgumentException(
+{III}$"Expected a finite number, but got: {{number}}");
+{I}}}
+
+{I}// Negative zero is also written as 0.
+{I}if (number == 0)
+{I}{{
+{II}builder.Append('0');
+{II}return;
+{I}}}
+
+{I}if (number < 0)
+{I}{{
+{II}builder.Append('-');
+{II}number = -number;
+{I}}}
+
+{I}// The format "R" gives the shortest representation which round-trips.
+{I}string roundTrip = number.ToString(
+{II}"R",
+{II}System.Globalization.CultureInfo.InvariantCulture);
+
+{I}string mantissa = roundTrip;
+{I}int exponent = 0;
+{I}int exponentStart = roundTrip.IndexOf('E');
+{I}if (exponentStart >= 0)
+{I}{{
+{II}mantissa = roundTrip.Substring(0, exponentStart);
+{II}exponent = int.Parse(
+{III}roundTrip.Substring(exponentStart + 1),
+{III}System.Globalization.NumberStyles.AllowLeadingSign,
+{III}System.Globalization.CultureInfo.InvariantCulture);
+{I}}}
+
+{I}// We decompose the number as 0.d1...dk * 10^n.
+{I}string digits;
+{I}int n;
+{I}int point = mantissa.IndexOf('.');
+{I}if (point >= 0)
+{I}{{
+{II}digits = mantissa.Substring(0, point) + mantissa.Substring(point + 1);
+{II}n = point + exponent;
+{I}}}
+{I}else
+{I}{{
+{II}digits = mantissa;
+{II}n = mantissa.Length + exponent;
+{I}}}
+
+{I}int leadingZeros = 0;
+{I}while (digits[leadingZeros] == '0')
+{I}{{
+{II}leadingZeros++;
+{I}}}
+{I}digits = digits.Substring(leadingZeros).TrimEnd('0');
+{I}n -= leadingZeros;
+
+{I}int k = digits.Length;
+
+{I}if (k <= n && n <= 21)
+{I}{{
+{II}builder.Append(digits);
+{II}builder.Append('0', n - k);
+{I}}}
+{I}else if (0 < n && n <= 21)
+{I}{{
+{II}builder.Append(digits, 0, n);
+{II}builder.Append('.');
+{II}builder.Append(digits, n, k - n);
+{I}}}
+{I}else if (-6 < n && n <= 0)
+{I}{{
+{II}builder.Append("0.");
+{II}builder.Append('0', -n);
+{II}builder.Append(digits);
+{I}}}
+{I}else
+{I}{{
+{II}builder.Append(digits[0]);
+{II}if (k > 1)
+{II}{{
+{III}builder.Append('.');
+{III}builder.Append(digits, 1, k - 1);
+{II}}}
+{II}builder.Append('e');
+{II}builder.Append(n - 1 >= 0 ? '+' : '-');
+{II}builder.Append(System.Math.Abs(n - 1));
+{I}}}
+}}"""
+    )
+
+
+def _generate_write_canonical() -> Stripped:
+    """Generate the function which writes a JSON node in the canonical form."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Write <paramref name="node" /> in the canonical form
+/// to <paramref name="builder" />.
+/// </summary>
+/// <remarks>
+/// The properties of JSON objects are sorted by the UTF-16 code units
+/// of their names as required by RFC 8785 so that the output does not
+/// depend on the order in which the serializer emitted them.
+/// </remarks>
+private static void WriteCanonical(
+{I}Nodes.JsonNode? node,
+{I}System.Text.StringBuilder builder)
+{{
+{I}switch (node)
+{I}{{
+{II}case null:
+{III}builder.Append("null");
+{III}break;
+{II}case Nodes.JsonObject jsonObject:
+{II}{{
+{III}var keys = new List<string>();
+{III}foreach (var pair in jsonObject)
+{III}{{
+{III}{I}keys.Add(pair.Key);
+{III}}}
+{III}keys.Sort(System.StringComparer.Ordinal);
+
+{III}builder.Append('{{');
+{III}for (int i = 0; i < keys.Count; i++)
+{III}{{
+{III}{I}if (i > 0)
+{III}{I}{{
+{III}{II}builder.Append(',');
+{III}{I}}}
+
+{III}{I}WriteCanonicalString(keys[i], builder);
+{III}{I}builder.Append(':');
+{III}{I}WriteCanonical(jsonObject[keys[i]], builder);
+{III}}}
+{III}builder.Append('}}');
+{III}break;
+{II}}}
+{II}case Nodes.JsonArray jsonArray:
+{II}{{
+{III}builder.Append('[');
+{III}for (int i = 0; i < jsonArray.Count; i++)
+{III}{{
+{III}{I}if (i > 0)
+{III}{I}{{
+{III}{II}builder.Append(',');
+{III}{I}}}
+
+{III}{I}WriteCanonical(jsonArray[i], builder);
+{III}}}
+{III}builder.Append(']');
+{III}break;
+{II}}}
+{II}case Nodes.JsonValue jsonValue:
+{II}{{
+{III}if (jsonValue.TryGetValue<string>(out string? text))
+{III}{{
+{III}{I}WriteCanonicalString(text, builder);
+{III}}}
+{III}else if (jsonValue.TryGetValue<bool>(out bool boolean))
+{III}{{
+{III}{I}builder.Append(boolean ? "true" : "false");
+{III}}}
+{III}else
+{III}{{
+{III}{I}double number = double.Parse(
+{III}{II}jsonValue.ToJsonString(),
+{III}{II}System.Globalization.NumberStyles.Float,
+{III}{II}System.Globalization.CultureInfo.InvariantCulture);
+{III}{I}WriteCanonicalNumber(number, builder);
+{III}}}
+{III}break;
+{II}}}
+{II}default:
+{III}throw new System.ArgumentException(
+{III}{I}$"Unexpected JSON node: {{node.GetType()}}");
+{I}}}
+}}"""
+    )
+
+
+def _generate_canonical_json() -> Stripped:
+    """Generate the function for canonical serialization of an instance."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Serialize <paramref name="that" /> to canonical UTF-8 encoded JSON.
+/// </summary>
+/// <remarks>
+/// The canonical form follows the JSON Canonicalization Scheme
+/// (JCS, RFC 8785), so that equal instances always result in equal bytes.
+/// As required by JCS, the numbers are handled as IEEE 754 doubles so
+/// the integers beyond 2^53 lose their precision.
+/// </remarks>
+public static byte[] CanonicalJson(Aas.IClass that)
+{{
+{I}Nodes.JsonObject jsonObject = Jsonization.Serialize.ToJsonObject(that);
+
+{I}var builder = new System.Text.StringBuilder();
+{I}WriteCanonical(jsonObject, builder);
+
+{I}return System.Text.Encoding.UTF8.GetBytes(builder.ToString());
+}}"""
+    )
+
+
+def _generate_digest() -> Stripped:
+    """Generate the function which computes the digest of an instance."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Compute the SHA-256 digest of <paramref name="that" />
+/// as a lowercase hexadecimal string.
+/// </summary>
+/// <remarks>
+/// The digest is computed over <see cref="CanonicalJson" />, so that
+/// it is stable across runs and platforms, and equals the digest computed
+/// by any other implementation of RFC 8785 over the same JSON.
+/// </remarks>
+public static string Digest(Aas.IClass that)
+{{
+{I}byte[] canonical = CanonicalJson(that);
+
+{I}byte[] hash;
+{I}using (var sha256 = System.Security.Cryptography.SHA256.Create())
+{I}{{
+{II}hash = sha256.ComputeHash(canonical);
+{I}}}
+
+{I}var builder = new System.Text.StringBuilder(2 * hash.Length);
+{I}foreach (byte b in hash)
+{I}{{
+{II}builder.Append(b.ToString("x2"));
+{I}}}
+
+{I}return builder.ToString();
+}}"""
+    )
+
+
+def _generate_etag(cls: intermediate.ClassUnion) -> Stripped:
+    """Generate the function which computes an entity tag of ``cls``."""
+    if isinstance(cls, intermediate.ConcreteClass) and (
+        len(cls.concrete_descendants) == 0
+    ):
+        cls_name = csharp_naming.class_name(cls.name)
+    else:
+        cls_name = csharp_naming.interface_name(cls.name)
+
+    return Stripped(
+        f"""\
+/// <summary>
+/// Compute a strong entity tag (ETag) of <paramref name="that" />
+/// based on its <see cref="Digest" />.
+/// </summary>
+/// <remarks>
+/// The result is quoted as required by RFC 7232 so that it can be
+/// directly used as the value of the <c>ETag</c> HTTP header.
+/// </remarks>
+public static string ETag(Aas.{cls_name} that)
+{{
+{I}return $"\\"{{Digest(that)}}\\"";
+}}"""
+    )
+
+
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def _find_etag_class(
+    symbol_table: intermediate.SymbolTable,
+    spec_impls: specific_implementations.SpecificImplementations,
+) -> Tuple[Optional[intermediate.ClassUnion], Optional[Error]]:
+    """
+    Find the class of which instances are tagged, if specified in ``spec_impls``.
+
+    If no class has been specified, return ``(None, None)``.
+    """
+    key = specific_implementations.ImplementationKey("Digestion/etag_class.txt")
+    name = spec_impls.get(key, None)
+    if name is None:
+        return None, None
+
+    if not IDENTIFIER_RE.fullmatch(name):
+        return None, Error(
+            None,
+            f"Expected the snippet {key} to contain the name of a class "
+            f"from the meta-model, but got: {name!r}",
+        )
+
+    our_type = symbol_table.find_our_type(Identifier(name))
+    if our_type is None:
+        return None, Error(
+            None,
+            f"The class {name!r} given in the snippet {key} "
+            f"could not be found in the meta-model",
+        )
+
+    if not isinstance(
+        our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+    ):
+        return None, Error(
+            our_type.parsed.node,
+            f"Expected {name!r} given in the snippet {key} to be a class, "
+            f"but got: {type(our_type).__name__}",
+        )
+
+    return our_type, None
+
+
+# fmt: off
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+@ensure(
+    lambda result:
+    not (result[0] is not None) or result[0].endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable,
+    namespace: csharp_common.NamespaceIdentifier,
+    spec_impls: specific_implementations.SpecificImplementations,
+) -> Tuple[Optional[str], Optional[List[Error]]]:
+    """
+    Generate the C# code for computing digests of the model instances.
+
+    The ``namespace`` defines the AAS C# namespace.
+
+    The entity tags are generated only for the class given in the snippet
+    ``Digestion/etag_class.txt``, if any.
+    """
+    etag_class, error = _find_etag_class(
+        symbol_table=symbol_table, spec_impls=spec_impls
+    )
+    if error is not None:
+        return None, [error]
+
+    digestion_blocks = [
+        _generate_write_canonical_string(),
+        _generate_write_canonical_number(),
+        _generate_write_canonical(),
+        _generate_canonical_json(),
+        _generate_digest(),
+    ]  # type: List[Stripped]
+
+    if etag_class is not None:
+        digestion_blocks.append(_generate_etag(cls=etag_class))
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Compute stable digests of model instances, e.g., for change detection.
+{I}/// </summary>
+{I}public static class Digestion
+{I}{{
+"""
+    )
+
+    for i, digestion_block in enumerate(digestion_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(digestion_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Digestion")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using Nodes = System.Text.Json.Nodes;
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue(), None
@@ -0,0 +1,4 @@
+"""Generate C# factories of minimal instances of the model."""
+from aas_core_codegen.csharp.factories import _generate
+
+generate = _generate.generate
@@ -0,0 +1,683 @@
+"""Generate C# factories of minimal instances of the model."""
+
+import io
+import re
+import textwrap
+from typing import Tuple, Optional, List, Mapping, Sequence, Set, Union
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate, specific_implementations, infer_for_schema
+from aas_core_codegen.common import Error, Stripped, Identifier, assert_never
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II
+from aas_core_codegen.parse import retree as parse_retree, tree as parse_tree
+
+_PRIMITIVE_DEFAULT_MAP = {
+    intermediate.PrimitiveType.BOOL: Stripped("false"),
+    intermediate.PrimitiveType.INT: Stripped("0"),
+    intermediate.PrimitiveType.FLOAT: Stripped("0.0"),
+    intermediate.PrimitiveType.STR: Stripped('"something"'),
+    intermediate.PrimitiveType.BYTEARRAY: Stripped("new byte[] { 0x00 }"),
+}  # type: Mapping[intermediate.PrimitiveType, Stripped]
+
+
+def _representative_class(
+    cls: intermediate.ClassUnion,
+) -> Optional[intermediate.ConcreteClass]:
+    """Pick the concrete class used to instantiate ``cls``, if any."""
+    if isinstance(cls, intermediate.ConcreteClass):
+        return cls
+
+    if len(cls.concrete_descendants) == 0:
+        return None
+
+    return cls.concrete_descendants[0]
+
+
+def _factory_name(cls: intermediate.ConcreteClass) -> Identifier:
+    """Generate the name of the factory method for ``cls``."""
+    return csharp_naming.method_name(Identifier(f"create_minimal_{cls.name}"))
+
+
+class _ExampleGenerator(parse_retree.Transformer[Optional[str]]):
+    """
+    Generate an example text for a regular expression.
+
+    The quantified terms are repeated :py:attr:`repetitions` times, clamped to
+    the bounds of the quantifier. If no example can be generated, *e.g.*, due to
+    formatted values, None is returned.
+    """
+
+    def __init__(self, repetitions: int) -> None:
+        """Initialize with the given values."""
+        self.repetitions = repetitions
+
+    def transform_regex(self, node: parse_retree.Regex) -> Optional[str]:
+        """Transform the ``regex``."""
+        return self.transform(node.union)
+
+    def transform_union_expr(self, node: parse_retree.UnionExpr) -> Optional[str]:
+        """Transform the ``union_expr``."""
+        for uniate in node.uniates:
+            example = self.transform(uniate)
+            if example is not None:
+                return example
+
+        return None
+
+    def transform_concatenation(
+        self, node: parse_retree.Concatenation
+    ) -> Optional[str]:
+        """Transform the ``concatenation``."""
+        parts = []  # type: List[str]
+        for concatenant in node.concatenants:
+            part = self.transform(concatenant)
+            if part is None:
+                return None
+
+            parts.append(part)
+
+        return "".join(parts)
+
+    def transform_symbol(self, node: parse_retree.Symbol) -> Optional[str]:
+        """Transform the ``symbol``."""
+        if (
+            node.kind is parse_retree.SymbolKind.START
+            or node.kind is parse_retree.SymbolKind.END
+        ):
+            return ""
+
+        elif node.kind is parse_retree.SymbolKind.DOT:
+            return "x"
+
+        else:
+            assert_never(node.kind)
+
+        raise AssertionError("Should not have gotten here")
+
+    def transform_term(self, node: parse_retree.Term) -> Optional[str]:
+        """Transform the ``term``."""
+        if isinstance(node.value, parse_tree.FormattedValue):
+            return None
+
+        example = self.transform(node.value)
+        if example is None:
+            return None
+
+        if node.quantifier is None:
+            return example
+
+        count = max(self.repetitions, node.quantifier.minimum)
+        if node.quantifier.maximum is not None:
+            count = min(count, node.quantifier.maximum)
+
+        return example * count
+
+    def transform_group(self, node: parse_retree.Group) -> Optional[str]:
+        """Transform the ``group``."""
+        return self.transform(node.union)
+
+    def transform_char(self, node: parse_retree.Char) -> Optional[str]:
+        """Transform the ``char``."""
+        return node.character
+
+    def transform_quantifier(self, node: parse_retree.Quantifier) -> Optional[str]:
+        """Transform the ``quantifier``."""
+        raise AssertionError("The quantifiers are handled in the terms")
+
+    def transform_char_set(self, node: parse_retree.CharSet) -> Optional[str]:
+        """Transform the ``char_set``."""
+        if not node.complementing:
+            if len(node.ranges) == 0:
+                return None
+
+            return node.ranges[0].start.character
+
+        for candidate in "xX0_ ":
+            if not any(
+                range_.start.character
+                <= candidate
+                <= (range_.end or range_.start).character
+                for range_ in node.ranges
+            ):
+                return candidate
+
+        return None
+
+    def transform_range(self, node: parse_retree.Range) -> Optional[str]:
+        """Transform the ``range``."""
+        raise AssertionError("The ranges are handled in the character sets")
+
+
+#: Maximum number of repetitions of the quantified terms we try before we give up
+_MAX_REPETITIONS = 64
+
+
+def _generate_text(
+    len_constraint: Optional[infer_for_schema.LenConstraint],
+    pattern_constraints: Sequence[infer_for_schema.PatternConstraint],
+) -> Optional[str]:
+    """
+    Generate a text which satisfies the constraints.
+
+    Return None if we could not find such a text.
+    """
+    min_len = 0
+    max_len = None  # type: Optional[int]
+    if len_constraint is not None:
+        if len_constraint.min_value is not None:
+            min_len = len_constraint.min_value
+
+        max_len = len_constraint.max_value
+
+    if len(pattern_constraints) == 0:
+        text = "something"
+        if max_len is not None:
+            text = text[:max_len]
+
+        return text + "x" * max(0, min_len - len(text))
+
+    regexes = []  # type: List[parse_retree.Regex]
+    for pattern_constraint in pattern_constraints:
+        regex, error = parse_retree.parse(values=[pattern_constraint.pattern])
+        if error is not None:
+            return None
+
+        assert regex is not None
+        regexes.append(regex)
+
+    # NOTE: We generate the examples with an increasing number of repetitions
+    # as the length constraints and the other patterns might rule out
+    # the shortest examples.
+    for repetitions in range(_MAX_REPETITIONS):
+        generator = _ExampleGenerator(repetitions=repetitions)
+
+        for regex in regexes:
+            text = generator.transform(regex)
+            if text is None:
+                continue
+
+            if (
+                min_len <= len(text)
+                and (max_len is None or len(text) <= max_len)
+                and all(
+                    re.match(pattern_constraint.pattern, text) is not None
+                    for pattern_constraint in pattern_constraints
+                )
+            ):
+                return text
+
+    return None
+
+
+def _generate_primitive_literal(
+    value: Union[bool, int, float, str, bytearray]
+) -> Stripped:
+    """Generate the C# literal of a primitive ``value``."""
+    # NOTE: We have to check for bool before int as bool is a subclass of int.
+    if isinstance(value, bool):
+        return Stripped("true" if value else "false")
+
+    elif isinstance(value, int):
+        return Stripped(str(value))
+
+    elif isinstance(value, float):
+        return Stripped(repr(value))
+
+    elif isinstance(value, str):
+        return csharp_common.string_literal(value)
+
+    elif isinstance(value, bytearray):
+        return Stripped(
+            "new byte[] { " + ", ".join(f"0x{byte:02x}" for byte in value) + " }"
+        )
+
+    else:
+        assert_never(value)
+
+    raise AssertionError("Should not have gotten here")
+
+
+def _generate_primitive_value(
+    a_type: intermediate.PrimitiveType,
+    prop: Optional[intermediate.Property],
+    constraints_by_property: Optional[infer_for_schema.ConstraintsByProperty],
+) -> Optional[Stripped]:
+    """
+    Generate a value of ``a_type`` satisfying the constraints on ``prop``.
+
+    Return None if we could not infer such a value.
+    """
+    len_constraint = None  # type: Optional[infer_for_schema.LenConstraint]
+    pattern_constraints = []  # type: Sequence[infer_for_schema.PatternConstraint]
+
+    if prop is not None and constraints_by_property is not None:
+        set_of_primitives = constraints_by_property.set_of_primitives_by_property.get(
+            prop, None
+        )
+        if set_of_primitives is not None and len(set_of_primitives.literals) > 0:
+            return _generate_primitive_literal(set_of_primitives.literals[0].value)
+
+        len_constraint = constraints_by_property.len_constraints_by_property.get(
+            prop, None
+        )
+        pattern_constraints = constraints_by_property.patterns_by_property.get(prop, [])
+
+    if a_type is intermediate.PrimitiveType.STR:
+        text = _generate_text(
+            len_constraint=len_constraint, pattern_constraints=pattern_constraints
+        )
+        if text is None:
+            return None
+
+        return csharp_common.string_literal(text)
+
+    elif a_type is intermediate.PrimitiveType.BYTEARRAY:
+        count = 1
+        if len_constraint is not None:
+            if len_constraint.min_value is not None:
+                count = len_constraint.min_value
+            elif len_constraint.max_value is not None:
+                count = min(count, len_constraint.max_value)
+
+        return _generate_primitive_literal(bytearray(count))
+
+    else:
+        return _PRIMITIVE_DEFAULT_MAP[a_type]
+
+
+def _generate_value(
+    type_annotation: intermediate.TypeAnnotationUnion,
+    prop: Optional[intermediate.Property],
+    constraints_by_property: Optional[infer_for_schema.ConstraintsByProperty],
+    spec_impls: specific_implementations.SpecificImplementations,
+) -> Tuple[Optional[Stripped], Optional[Error]]:
+    """
+    Generate a value of the ``type_annotation`` for the property ``prop``.
+
+    The value satisfies the constraints of ``prop`` in ``constraints_by_property``.
+    The ``prop`` is None for the items of a list.
+    """
+    if isinstance(type_annotation, intermediate.PrimitiveTypeAnnotation):
+        value = _generate_primitive_value(
+            a_type=type_annotation.a_type,
+            prop=prop,
+            constraints_by_property=constraints_by_property,
+        )
+        if value is None:
+            assert prop is not None, "Only the constrained values can fail"
+            return None, Error(
+                prop.parsed.node,
+                f"We could not infer a value for the property {prop.name!r} "
+                f"which satisfies its constraints",
+            )
+
+        return value, None
+
+    elif isinstance(type_annotation, intermediate.OurTypeAnnotation):
+        our_type = type_annotation.our_type
+
+        if isinstance(our_type, intermediate.Enumeration):
+            assert (
+                len(our_type.literals) > 0
+            ), f"Unexpected enumeration without literals: {our_type.name}"
+
+            literal = our_type.literals[0]
+            if prop is not None and constraints_by_property is not None:
+                set_of_literals = (
+                    constraints_by_property.set_of_enumeration_literals_by_property.get(
+                        prop, None
+                    )
+                )
+                if set_of_literals is not None and len(set_of_literals.literals) > 0:
+                    literal = set_of_literals.literals[0]
+
+            enum_name = csharp_naming.enum_name(our_type.name)
+            literal_name = csharp_naming.enum_literal_name(literal.name)
+            return Stripped(f"Aas.{enum_name}.{literal_name}"), None
+
+        elif isinstance(our_type, intermediate.ConstrainedPrimitive):
+            # NOTE: The snippet takes precedence as the user knows best which
+            # value satisfies all the invariants, including those which we can
+            # not infer.
+            value_key = specific_implementations.ImplementationKey(
+                f"Minimal/{our_type.name}.cs"
+            )
+            value = spec_impls.get(value_key, None)
+            if value is not None:
+                return value, None
+
+            # NOTE: The constraints of the constrained primitive are in-lined in
+            # the constraints of the property. We do not have the constraints for
+            # the items of a list, so the user needs to supply a snippet.
+            if prop is not None:
+                value = _generate_primitive_value(
+                    a_type=our_type.constrainee,
+                    prop=prop,
+                    constraints_by_property=constraints_by_property,
+                )
+
+            if value is None:
+                return None, Error(
+                    our_type.parsed.node,
+                    f"We could not infer a value for the constrained primitive "
+                    f"{our_type.name!r} which satisfies its constraints. "
+                    f"Please supply the value with the snippet {value_key!r}",
+                )
+
+            return value, None
+
+        elif isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            concrete_cls = _representative_class(our_type)
+            assert concrete_cls is not None, (
+                f"Unexpected class without concrete descendants "
+                f"in a required property: {our_type.name}"
+            )
+
+            return Stripped(f"{_factory_name(concrete_cls)}()"), None
+
+        else:
+            assert_never(our_type)
+
+    elif isinstance(type_annotation, intermediate.ListTypeAnnotation):
+        item_type = csharp_common.generate_type(type_annotation.items)
+        item_value, error = _generate_value(
+            type_annotation=type_annotation.items,
+            prop=None,
+            constraints_by_property=None,
+            spec_impls=spec_impls,
+        )
+        if error is not None:
+            return None, error
+
+        assert item_value is not None
+
+        count = 1
+        if prop is not None and constraints_by_property is not None:
+            len_constraint = constraints_by_property.len_constraints_by_property.get(
+                prop, None
+            )
+            if len_constraint is not None and len_constraint.min_value is not None:
+                count = len_constraint.min_value
+
+        item_code = textwrap.indent(item_value, I).lstrip()
+        items_code = ",\n".join(f"{I}{item_code}" for _ in range(count))
+
+        return (
+            Stripped(
+                f"""\
+new List<{item_type}>()
+{{
+{items_code}
+}}"""
+            ),
+            None,
+        )
+
+    elif isinstance(type_annotation, intermediate.OptionalTypeAnnotation):
+        return Stripped("null"), None
+
+    else:
+        assert_never(type_annotation)
+
+    raise AssertionError("Should not have gotten here")
+
+
+def _required_classes(
+    cls: intermediate.ConcreteClass,
+) -> List[Optional[intermediate.ConcreteClass]]:
+    """
+    List the classes instantiated by the factory of ``cls``.
+
+    None stands for a class which can not be instantiated.
+    """
+    result = []  # type: List[Optional[intermediate.ConcreteClass]]
+
+    for arg in cls.constructor.arguments:
+        if arg.default is not None:
+            continue
+
+        type_anno = arg.type_annotation
+        while isinstance(type_anno, intermediate.ListTypeAnnotation):
+            type_anno = type_anno.items
+
+        if isinstance(type_anno, intermediate.OurTypeAnnotation) and isinstance(
+            type_anno.our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            result.append(_representative_class(type_anno.our_type))
+
+    return result
+
+
+def _verify(symbol_table: intermediate.SymbolTable) -> Optional[List[Error]]:
+    """Check that all the minimal instances can be constructed in finite steps."""
+    errors = []  # type: List[Error]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(our_type, intermediate.ConcreteClass):
+            continue
+
+        if any(required is None for required in _required_classes(our_type)):
+            errors.append(
+                Error(
+                    our_type.parsed.node,
+                    f"The class {our_type.name!r} requires an instance of "
+                    f"an abstract class without concrete descendants, so "
+                    f"we can not generate its minimal factory",
+                )
+            )
+            continue
+
+        # We perform a depth-first search to detect the cycles over the required
+        # properties as the factories would recurse infinitely otherwise.
+        visited = set()  # type: Set[int]
+        stack = [our_type]  # type: List[intermediate.ConcreteClass]
+        while len(stack) > 0:
+            cls = stack.pop()
+            for required in _required_classes(cls):
+                if required is None:
+                    continue
+
+                if required is our_type:
+                    errors.append(
+                        Error(
+                            our_type.parsed.node,
+                            f"The class {our_type.name!r} requires itself "
+                            f"transitively over the required properties, so "
+                            f"we can not generate its minimal factory",
+                        )
+                    )
+                    stack = []
+                    break
+
+                if id(required) not in visited:
+                    visited.add(id(required))
+                    stack.append(required)
+
+    if len(errors) > 0:
+        return errors
+
+    return None
+
+
+def _generate_factory(
+    cls: intermediate.ConcreteClass,
+    constraints_by_property: infer_for_schema.ConstraintsByProperty,
+    spec_impls: specific_implementations.SpecificImplementations,
+) -> Tuple[Optional[Stripped], Optional[List[Error]]]:
+    """Generate the factory of the minimal instance of ``cls``."""
+    cls_name = csharp_naming.class_name(cls.name)
+
+    errors = []  # type: List[Error]
+
+    arg_codes = []  # type: List[str]
+    for arg in cls.constructor.arguments:
+        if arg.default is not None:
+            continue
+
+        arg_name = csharp_naming.argument_name(arg.name)
+        value, error = _generate_value(
+            type_annotation=arg.type_annotation,
+            prop=cls.properties_by_name.get(arg.name, None),
+            constraints_by_property=constraints_by_property,
+            spec_impls=spec_impls,
+        )
+        if error is not None:
+            errors.append(error)
+            continue
+
+        assert value is not None
+
+        arg_codes.append(f"{arg_name}: {textwrap.indent(value, I).lstrip()}")
+
+    if len(errors) > 0:
+        return None, errors
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+/// <summary>
+/// Create a minimal instance of <see cref="Aas.{cls_name}" />
+/// with only the required properties set.
+/// </summary>
+public static Aas.{cls_name} {_factory_name(cls)}()
+{{
+"""
+    )
+
+    if len(arg_codes) == 0:
+        writer.write(f"{I}return new Aas.{cls_name}();\n}}")
+    else:
+        writer.write(f"{I}return new Aas.{cls_name}(\n")
+        writer.write(textwrap.indent(",\n".join(arg_codes), II))
+        writer.write(");\n}")
+
+    return Stripped(writer.getvalue()), None
+
+
+# fmt: off
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+@ensure(
+    lambda result:
+    not (result[0] is not None) or result[0].endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable,
+    namespace: csharp_common.NamespaceIdentifier,
+    spec_impls: specific_implementations.SpecificImplementations,
+) -> Tuple[Optional[str], Optional[List[Error]]]:
+    """
+    Generate the C# code of the factories for minimal instances.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    verification_errors = _verify(symbol_table=symbol_table)
+    if verification_errors is not None:
+        return None, verification_errors
+
+    # NOTE: We infer the constraints the same way as for the generation of
+    # the test data so that the minimal instances satisfy them as well.
+    (
+        constraints_by_class,
+        inference_errors,
+    ) = infer_for_schema.infer_constraints_by_class(symbol_table=symbol_table)
+    if inference_errors is not None:
+        return None, inference_errors
+
+    assert constraints_by_class is not None
+
+    constraints_by_class, error = infer_for_schema.merge_constraints_with_ancestors(
+        symbol_table=symbol_table, constraints_by_class=constraints_by_class
+    )
+    if error is not None:
+        return None, [error]
+
+    assert constraints_by_class is not None
+
+    errors = []  # type: List[Error]
+
+    factory_blocks = []  # type: List[Stripped]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(our_type, intermediate.ConcreteClass):
+            continue
+
+        factory_block, factory_errors = _generate_factory(
+            cls=our_type,
+            constraints_by_property=constraints_by_class[our_type],
+            spec_impls=spec_impls,
+        )
+        if factory_errors is not None:
+            errors.extend(factory_errors)
+            continue
+
+        assert factory_block is not None
+        factory_blocks.append(factory_block)
+
+    if len(errors) > 0:
+        return None, errors
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Create minimal instances of the model, e.g., for unit tests and tutorials.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// <para>
+{I}/// The required properties are set to values which satisfy the constraints
+{I}/// on their length, their patterns and their sets of allowed values.
+{I}/// The other invariants of the model are <em>not</em> guaranteed to hold,
+{I}/// so call <see cref="Verification.Verify(Aas.IClass)" /> if you need valid
+{I}/// instances.
+{I}/// </para>
+{I}/// <para>
+{I}/// The values of constrained primitives can be given explicitly by
+{I}/// the snippets <c>Minimal/{{name of the constrained primitive}}.cs</c>.
+{I}/// </para>
+{I}/// </remarks>
+{I}public static class Factories
+{I}{{
+"""
+    )
+
+    for i, factory_block in enumerate(factory_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(factory_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Factories")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue(), None
@@ -0,0 +1,4 @@
+"""Generate C# harnesses for fuzzing the deserialization."""
+from aas_core_codegen.csharp.fuzzing import _generate
+
+generate = _generate.generate
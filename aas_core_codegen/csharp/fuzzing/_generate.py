@@ -0,0 +1,268 @@
+"""Generate C# harnesses for fuzzing the deserialization."""
+
+import io
+import textwrap
+from typing import List, Sequence
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_dispatch(
+    concrete_classes: Sequence[intermediate.ConcreteClass],
+    serialization: str,
+    argument: str,
+) -> Stripped:
+    """
+    Generate the switch which selects the deserialization by the selector byte.
+
+    The ``serialization`` is either ``Jsonization`` or ``Xmlization``, while
+    the ``argument`` is passed on to the deserialization function.
+    """
+    cls_names = [csharp_naming.class_name(cls.name) for cls in concrete_classes]
+
+    writer = io.StringIO()
+    writer.write(f"switch (selector % {len(cls_names)})\n{{\n")
+
+    for i, cls_name in enumerate(cls_names):
+        writer.write(
+            f"""\
+{I}case {i}:
+{II}return {serialization}.Deserialize.{cls_name}From({argument});
+"""
+        )
+
+    writer.write(
+        f"""\
+{I}default:
+{II}throw new System.InvalidOperationException(
+{III}$"Unexpected selector: {{selector}}");
+}}"""
+    )
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_fuzz_json(
+    concrete_classes: Sequence[intermediate.ConcreteClass],
+) -> Stripped:
+    """Generate the harness for fuzzing the JSON deserialization."""
+    dispatch = _generate_dispatch(
+        concrete_classes=concrete_classes, serialization="Jsonization", argument="node"
+    )
+
+    return Stripped(
+        f"""\
+private static Aas.IClass DeserializeJson(byte selector, Nodes.JsonNode node)
+{{
+{I}{textwrap.indent(dispatch, I).lstrip()}
+}}
+
+/// <summary>
+/// Fuzz the JSON deserialization with <paramref name="data" />.
+/// </summary>
+/// <remarks>
+/// <para>
+/// The first byte selects the class to be deserialized, while the remaining
+/// bytes are parsed as UTF-8 JSON. A successfully deserialized instance is
+/// further serialized and verified.
+/// </para>
+/// <para>
+/// The errors expected on invalid input are swallowed. Any other exception
+/// is a finding. Pass this function to your fuzzing engine, <em>e.g.</em>,
+/// <c>SharpFuzz.Fuzzer.LibFuzzer.Run(Fuzzing.FuzzJson)</c>.
+/// </para>
+/// </remarks>
+public static void FuzzJson(System.ReadOnlySpan<byte> data)
+{{
+{I}if (data.Length == 0)
+{I}{{
+{II}return;
+{I}}}
+
+{I}Nodes.JsonNode? node;
+{I}try
+{I}{{
+{II}node = Nodes.JsonNode.Parse(data.Slice(1));
+{I}}}
+{I}catch (System.Text.Json.JsonException)
+{I}{{
+{II}return;
+{I}}}
+
+{I}if (node == null)
+{I}{{
+{II}return;
+{I}}}
+
+{I}Aas.IClass instance;
+{I}try
+{I}{{
+{II}instance = DeserializeJson(data[0], node);
+{I}}}
+{I}catch (Jsonization.Exception)
+{I}{{
+{II}return;
+{I}}}
+
+{I}Jsonization.Serialize.ToJsonObject(instance);
+
+{I}foreach (var _ in Verification.Verify(instance))
+{I}{{
+{II}// Intentionally empty.
+{I}}}
+}}"""
+    )
+
+
+def _generate_fuzz_xml(
+    concrete_classes: Sequence[intermediate.ConcreteClass],
+) -> Stripped:
+    """Generate the harness for fuzzing the XML deserialization."""
+    dispatch = _generate_dispatch(
+        concrete_classes=concrete_classes,
+        serialization="Xmlization",
+        argument="reader",
+    )
+
+    return Stripped(
+        f"""\
+private static Aas.IClass DeserializeXml(byte selector, Xml.XmlReader reader)
+{{
+{I}{textwrap.indent(dispatch, I).lstrip()}
+}}
+
+/// <summary>
+/// Fuzz the XML deserialization with <paramref name="data" />.
+/// </summary>
+/// <remarks>
+/// <para>
+/// The first byte selects the class to be deserialized, while the remaining
+/// bytes are read as an XML document. A successfully deserialized instance is
+/// further verified.
+/// </para>
+/// <para>
+/// The errors expected on invalid input are swallowed. Any other exception
+/// is a finding. Pass this function to your fuzzing engine, <em>e.g.</em>,
+/// <c>SharpFuzz.Fuzzer.LibFuzzer.Run(Fuzzing.FuzzXml)</c>.
+/// </para>
+/// </remarks>
+public static void FuzzXml(System.ReadOnlySpan<byte> data)
+{{
+{I}if (data.Length == 0)
+{I}{{
+{II}return;
+{I}}}
+
+{I}using var stream = new System.IO.MemoryStream(data.Slice(1).ToArray());
+{I}using var reader = Xml.XmlReader.Create(stream);
+
+{I}Aas.IClass instance;
+{I}try
+{I}{{
+{II}reader.MoveToContent();
+{II}instance = DeserializeXml(data[0], reader);
+{I}}}
+{I}catch (Xml.XmlException)
+{I}{{
+{II}return;
+{I}}}
+{I}catch (Xmlization.Exception)
+{I}{{
+{II}return;
+{I}}}
+
+{I}foreach (var _ in Verification.Verify(instance))
+{I}{{
+{II}// Intentionally empty.
+{I}}}
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+) -> str:
+    """
+    Generate the C# harnesses for fuzzing the deserialization.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    concrete_classes = [
+        our_type
+        for our_type in symbol_table.our_types
+        if isinstance(our_type, intermediate.ConcreteClass)
+    ]  # type: List[intermediate.ConcreteClass]
+
+    fuzzing_blocks = []  # type: List[Stripped]
+
+    if len(concrete_classes) > 0:
+        fuzzing_blocks.append(_generate_fuzz_json(concrete_classes=concrete_classes))
+        fuzzing_blocks.append(_generate_fuzz_xml(concrete_classes=concrete_classes))
+    else:
+        fuzzing_blocks.append(
+            Stripped("// There are no concrete classes to be deserialized.")
+        )
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Provide the harnesses for fuzzing the deserialization.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// The harnesses do not depend on any fuzzing engine so that you can plug them
+{I}/// into SharpFuzz, libFuzzer or any other engine of your choice.
+{I}/// </remarks>
+{I}public static class Fuzzing
+{I}{{
+"""
+    )
+
+    for i, fuzzing_block in enumerate(fuzzing_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(fuzzing_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Fuzzing")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using Nodes = System.Text.Json.Nodes;
+using Xml = System.Xml;
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -0,0 +1,5 @@
+"""Generate IDE snippets for constructing the instances with the C# SDK."""
+from aas_core_codegen.csharp.ide_snippets import _generate
+
+generate_vs_code = _generate.generate_vs_code
+generate_live_templates = _generate.generate_live_templates
@@ -0,0 +1,318 @@
+"""Generate IDE snippets for constructing the instances with the C# SDK."""
+
+import collections
+import io
+import json
+import xml.sax.saxutils
+from typing import List, Mapping, Set, Union, MutableMapping, Any
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Identifier, assert_never
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I
+
+# We expand the nested instances only up to this depth so that the snippets
+# remain short enough to be useful in the editor.
+_MAX_DEPTH = 2
+
+_PRIMITIVE_DEFAULT_MAP = {
+    intermediate.PrimitiveType.BOOL: "false",
+    intermediate.PrimitiveType.INT: "0",
+    intermediate.PrimitiveType.FLOAT: "0.0",
+    intermediate.PrimitiveType.BYTEARRAY: "new byte[] { }",
+}  # type: Mapping[intermediate.PrimitiveType, str]
+
+
+class _Placeholder:
+    """Represent a field of the snippet which the user fills out."""
+
+    def __init__(self, name: Identifier, default: str) -> None:
+        """Initialize with the given values."""
+        self.name = name
+        self.default = default
+
+
+_Part = Union[str, _Placeholder]
+
+
+class _Builder:
+    """Build the parts of a snippet while keeping the placeholder names unique."""
+
+    def __init__(self) -> None:
+        """Initialize with no parts."""
+        self.parts = []  # type: List[_Part]
+        self._names = set()  # type: Set[Identifier]
+
+    def text(self, text: str) -> None:
+        """Append the literal ``text``."""
+        self.parts.append(text)
+
+    def placeholder(self, name: Identifier, default: str) -> None:
+        """Append a placeholder with a name unique within the snippet."""
+        unique_name = name
+        counter = 1
+        while unique_name in self._names:
+            counter += 1
+            unique_name = Identifier(f"{name}{counter}")
+
+        self._names.add(unique_name)
+        self.parts.append(_Placeholder(name=unique_name, default=default))
+
+
+class _Snippet:
+    """Represent a snippet independent of the IDE."""
+
+    def __init__(self, prefix: str, description: str, parts: List[_Part]) -> None:
+        """Initialize with the given values."""
+        self.prefix = prefix
+        self.description = description
+        self.parts = parts
+
+
+def _generate_aliased_type(type_annotation: intermediate.TypeAnnotationUnion) -> str:
+    """Generate the C# type prefixed with the ``Aas`` alias where needed."""
+    if isinstance(type_annotation, intermediate.OurTypeAnnotation) and not isinstance(
+        type_annotation.our_type, intermediate.ConstrainedPrimitive
+    ):
+        return f"Aas.{csharp_common.generate_type(type_annotation)}"
+
+    return csharp_common.generate_type(type_annotation)
+
+
+def _write_value(
+    type_annotation: intermediate.TypeAnnotationUnion,
+    name: Identifier,
+    builder: _Builder,
+    indentation: str,
+    depth: int,
+) -> None:
+    """Write the placeholders for a value of ``type_annotation`` to ``builder``."""
+    if isinstance(type_annotation, intermediate.PrimitiveTypeAnnotation):
+        _write_primitive_value(
+            primitive_type=type_annotation.a_type, name=name, builder=builder
+        )
+
+    elif isinstance(type_annotation, intermediate.OurTypeAnnotation):
+        our_type = type_annotation.our_type
+
+        if isinstance(our_type, intermediate.Enumeration):
+            assert (
+                len(our_type.literals) > 0
+            ), f"Unexpected enumeration without literals: {our_type.name}"
+            enum_name = csharp_naming.enum_name(our_type.name)
+            literal_name = csharp_naming.enum_literal_name(our_type.literals[0].name)
+            builder.placeholder(name, f"Aas.{enum_name}.{literal_name}")
+
+        elif isinstance(our_type, intermediate.ConstrainedPrimitive):
+            _write_primitive_value(
+                primitive_type=our_type.constrainee, name=name, builder=builder
+            )
+
+        elif isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            if isinstance(our_type, intermediate.ConcreteClass) and depth < _MAX_DEPTH:
+                _write_construction(
+                    cls=our_type,
+                    builder=builder,
+                    indentation=indentation,
+                    depth=depth + 1,
+                )
+            else:
+                builder.placeholder(name, _generate_aliased_type(type_annotation))
+
+        else:
+            assert_never(our_type)
+
+    elif isinstance(type_annotation, intermediate.ListTypeAnnotation):
+        item_type = _generate_aliased_type(type_annotation.items)
+        builder.text(f"new List<{item_type}>\n{indentation}{{\n{indentation}{I}")
+        _write_value(
+            type_annotation=type_annotation.items,
+            name=name,
+            builder=builder,
+            indentation=indentation + I,
+            depth=depth,
+        )
+        builder.text(f"\n{indentation}}}")
+
+    elif isinstance(type_annotation, intermediate.OptionalTypeAnnotation):
+        _write_value(
+            type_annotation=type_annotation.value,
+            name=name,
+            builder=builder,
+            indentation=indentation,
+            depth=depth,
+        )
+
+    else:
+        assert_never(type_annotation)
+
+
+def _write_primitive_value(
+    primitive_type: intermediate.PrimitiveType, name: Identifier, builder: _Builder
+) -> None:
+    """Write the placeholder for a value of ``primitive_type`` to ``builder``."""
+    if primitive_type is intermediate.PrimitiveType.STR:
+        builder.text('"')
+        builder.placeholder(name, name)
+        builder.text('"')
+    else:
+        builder.placeholder(name, _PRIMITIVE_DEFAULT_MAP[primitive_type])
+
+
+def _write_construction(
+    cls: intermediate.ConcreteClass, builder: _Builder, indentation: str, depth: int
+) -> None:
+    """Write the constructor call of ``cls`` with the required arguments."""
+    cls_name = csharp_naming.class_name(cls.name)
+
+    required_args = [arg for arg in cls.constructor.arguments if arg.default is None]
+
+    if len(required_args) == 0:
+        builder.text(f"new Aas.{cls_name}()")
+        return
+
+    builder.text(f"new Aas.{cls_name}(")
+    for i, arg in enumerate(required_args):
+        arg_name = csharp_naming.argument_name(arg.name)
+
+        builder.text(f"\n{indentation}{I}{arg_name}: ")
+        _write_value(
+            type_annotation=arg.type_annotation,
+            name=arg_name,
+            builder=builder,
+            indentation=indentation + I,
+            depth=depth,
+        )
+
+        if i < len(required_args) - 1:
+            builder.text(",")
+
+    builder.text(")")
+
+
+def _generate_snippets(symbol_table: intermediate.SymbolTable) -> List[_Snippet]:
+    """Generate a snippet for every concrete class of the meta-model."""
+    result = []  # type: List[_Snippet]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(our_type, intermediate.ConcreteClass):
+            continue
+
+        cls_name = csharp_naming.class_name(our_type.name)
+
+        builder = _Builder()
+        _write_construction(cls=our_type, builder=builder, indentation="", depth=1)
+
+        result.append(
+            _Snippet(
+                prefix=f"aas{cls_name}",
+                description=(
+                    f"Construct an instance of Aas.{cls_name} "
+                    f"with the required properties"
+                ),
+                parts=builder.parts,
+            )
+        )
+
+    return result
+
+
+def _escape_vs_code(text: str) -> str:
+    """Escape the literal ``text`` for the body of a VS Code snippet."""
+    return text.replace("\\", "\\\\").replace("$", "\\$").replace("}", "\\}")
+
+
+def generate_vs_code(symbol_table: intermediate.SymbolTable) -> str:
+    """
+    Generate the VS Code snippets for constructing the instances.
+
+    The snippets assume the alias ``Aas`` for the namespace of the SDK.
+    """
+    snippets = collections.OrderedDict()  # type: MutableMapping[str, Any]
+
+    for snippet in _generate_snippets(symbol_table=symbol_table):
+        indices = dict()  # type: MutableMapping[Identifier, int]
+
+        writer = io.StringIO()
+        for part in snippet.parts:
+            if isinstance(part, str):
+                writer.write(_escape_vs_code(part))
+            elif isinstance(part, _Placeholder):
+                index = indices.setdefault(part.name, len(indices) + 1)
+                writer.write(f"${{{index}:{_escape_vs_code(part.default)}}}")
+            else:
+                assert_never(part)
+
+        snippets[snippet.prefix] = collections.OrderedDict(
+            [
+                ("prefix", snippet.prefix),
+                ("body", writer.getvalue().split("\n")),
+                ("description", snippet.description),
+            ]
+        )
+
+    return json.dumps(snippets, indent=2) + "\n"
+
+
+def _quote_xml_attribute(text: str) -> str:
+    """Escape and quote ``text`` as an XML attribute value."""
+    return '"{}"'.format(
+        xml.sax.saxutils.escape(text, {'"': "&quot;", "\n": "&#10;"})
+    )
+
+
+def generate_live_templates(symbol_table: intermediate.SymbolTable) -> str:
+    """
+    Generate the JetBrains live templates for constructing the instances.
+
+    The templates assume the alias ``Aas`` for the namespace of the SDK.
+    """
+    writer = io.StringIO()
+    writer.write('<templateSet group="AAS">\n')
+
+    for snippet in _generate_snippets(symbol_table=symbol_table):
+        variables = collections.OrderedDict()  # type: MutableMapping[str, str]
+
+        value_writer = io.StringIO()
+        for part in snippet.parts:
+            if isinstance(part, str):
+                value_writer.write(part.replace("$", "$$"))
+            elif isinstance(part, _Placeholder):
+                variables.setdefault(part.name, part.default)
+                value_writer.write(f"${part.name}$")
+            else:
+                assert_never(part)
+
+        writer.write(
+            f"{I}<template "
+            f"name={_quote_xml_attribute(snippet.prefix)} "
+            f"value={_quote_xml_attribute(value_writer.getvalue())} "
+            f"description={_quote_xml_attribute(snippet.description)} "
+            f'toReformat="true" toShortenFQNames="true">\n'
+        )
+
+        for name, default in variables.items():
+            # The default values of live templates are expressions, so we need
+            # to quote them as string literals.
+            writer.write(
+                f"{I}{I}<variable "
+                f"name={_quote_xml_attribute(name)} "
+                f'expression="" '
+                f"defaultValue={_quote_xml_attribute(json.dumps(default))} "
+                f'alwaysStopAt="true" />\n'
+            )
+
+        writer.write(
+            f"""\
+{I}{I}<context>
+{I}{I}{I}<option name="OTHER" value="true" />
+{I}{I}</context>
+{I}</template>
+"""
+        )
+
+    writer.write("</templateSet>\n")
+
+    return writer.getvalue()
@@ -0,0 +1,4 @@
+"""Generate C# code for tracing and measuring the processing of instances."""
+from aas_core_codegen.csharp.instrumentation import _generate
+
+generate = _generate.generate
@@ -0,0 +1,247 @@
+"""Generate C# code for tracing and measuring the processing of instances."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+#: Conditional compilation symbol which enables the instrumentation
+DEFINE = "AAS_CORE_INSTRUMENTATION"
+
+
+def _generate_measure(namespace: csharp_common.NamespaceIdentifier) -> Stripped:
+    """Generate the sources of the spans and metrics as well as the measuring."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Source of the tracing spans, one per call of an entry point
+/// </summary>
+public static readonly System.Diagnostics.ActivitySource ActivitySource =
+{I}new System.Diagnostics.ActivitySource({csharp_common.string_literal(namespace)});
+
+/// <summary>
+/// Meter of the processing time and the errors of the entry points
+/// </summary>
+public static readonly System.Diagnostics.Metrics.Meter Meter =
+{I}new System.Diagnostics.Metrics.Meter({csharp_common.string_literal(namespace)});
+
+private static readonly System.Diagnostics.Metrics.Histogram<double> Duration =
+{I}Meter.CreateHistogram<double>(
+{II}"aas.duration", "ms", "Time spent in an entry point");
+
+private static readonly System.Diagnostics.Metrics.Counter<long> Failures =
+{I}Meter.CreateCounter<long>(
+{II}"aas.failures", null, "Number of entry point calls which threw");
+
+private static T Measure<T>(string operation, System.Func<T> action)
+{{
+{I}using var activity = ActivitySource.StartActivity(operation);
+{I}var tag = new KeyValuePair<string, object?>("operation", operation);
+{I}var stopwatch = System.Diagnostics.Stopwatch.StartNew();
+
+{I}try
+{I}{{
+{II}return action();
+{I}}}
+{I}catch (System.Exception exception)
+{I}{{
+{II}activity?.SetStatus(
+{III}System.Diagnostics.ActivityStatusCode.Error, exception.Message);
+{II}Failures.Add(1, tag);
+{II}throw;
+{I}}}
+{I}finally
+{I}{{
+{II}Duration.Record(stopwatch.Elapsed.TotalMilliseconds, tag);
+{I}}}
+}}
+
+private static void Measure(string operation, System.Action action)
+{{
+{I}Measure<object?>(
+{II}operation,
+{II}() =>
+{II}{{
+{III}action();
+{III}return null;
+{II}}});
+}}"""
+    )
+
+
+def _generate_deserialize_from(name: str) -> Stripped:
+    """Generate the instrumented JSON and XML deserialization of the ``name``."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Trace and measure <see cref="Jsonization.Deserialize.{name}From" />.
+/// </summary>
+public static Aas.{name} {name}FromJson(
+{I}System.Text.Json.Nodes.JsonNode node)
+{{
+{I}return Measure(
+{II}"{name}FromJson",
+{II}() => Jsonization.Deserialize.{name}From(node));
+}}
+
+/// <summary>
+/// Trace and measure <see cref="Xmlization.Deserialize.{name}From" />.
+/// </summary>
+public static Aas.{name} {name}FromXml(
+{I}System.Xml.XmlReader reader,
+{I}string? ns = null)
+{{
+{I}return Measure(
+{II}"{name}FromXml",
+{II}() => Xmlization.Deserialize.{name}From(reader, ns));
+}}"""
+    )
+
+
+def _generate_verify_and_serialize() -> Stripped:
+    """Generate the instrumented verification as well as JSON and XML serialization."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Trace and measure <see cref="Verification.Verify" />.
+/// </summary>
+/// <remarks>
+/// The errors are collected eagerly so that the measurement covers
+/// the whole verification.
+/// </remarks>
+public static List<Reporting.Error> Verify(Aas.IClass that)
+{{
+{I}return Measure(
+{II}"Verify",
+{II}() => new List<Reporting.Error>(Verification.Verify(that)));
+}}
+
+/// <summary>
+/// Trace and measure <see cref="Jsonization.Serialize.ToJsonObject" />.
+/// </summary>
+public static System.Text.Json.Nodes.JsonObject ToJsonObject(Aas.IClass that)
+{{
+{I}return Measure(
+{II}"ToJsonObject",
+{II}() => Jsonization.Serialize.ToJsonObject(that));
+}}
+
+/// <summary>
+/// Trace and measure <see cref="Xmlization.Serialize.To" />.
+/// </summary>
+public static void ToXml(
+{I}Aas.IClass that,
+{I}System.Xml.XmlWriter writer,
+{I}string? prefix = null,
+{I}string? ns = null)
+{{
+{I}Measure(
+{II}"ToXml",
+{II}() => Xmlization.Serialize.To(that, writer, prefix, ns));
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+) -> str:
+    """
+    Generate the C# code for tracing and measuring the entry points.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    instrumentation_blocks = [
+        _generate_measure(namespace=namespace),
+    ]  # type: List[Stripped]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            continue
+
+        if our_type.interface is not None:
+            instrumentation_blocks.append(
+                _generate_deserialize_from(
+                    name=csharp_naming.interface_name(our_type.interface.name)
+                )
+            )
+
+        if isinstance(our_type, intermediate.ConcreteClass):
+            instrumentation_blocks.append(
+                _generate_deserialize_from(name=csharp_naming.class_name(our_type.name))
+            )
+
+    instrumentation_blocks.append(_generate_verify_and_serialize())
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Trace and measure the JSON and XML deserialization, the verification and
+{I}/// the JSON and XML serialization so that the operators can see where
+{I}/// the processing time goes.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// The spans and metrics follow <c>System.Diagnostics</c> and can be
+{I}/// exported with OpenTelemetry by listening to <see cref="ActivitySource" />
+{I}/// and <see cref="Meter" />. The operation of a span or a metric is named
+{I}/// after the corresponding method, e.g., <c>ToXml</c>.
+{I}/// </remarks>
+{I}public static class Instrumentation
+{I}{{
+"""
+    )
+
+    for i, instrumentation_block in enumerate(instrumentation_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(instrumentation_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Instrumentation")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    # The instrumentation is opt-in so that the SDK does not pay for it unless
+    # the users explicitly define the compilation symbol.
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(f"#if {DEFINE}"),
+        Stripped(
+            f"""\
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        Stripped(f"#endif  // {DEFINE}"),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -0,0 +1,4 @@
+"""Generate C# code for validating IRIs and URIs."""
+from aas_core_codegen.csharp.iri_validation import _generate
+
+generate = _generate.generate
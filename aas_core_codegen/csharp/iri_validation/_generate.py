@@ -0,0 +1,222 @@
+"""Generate C# code for validating IRIs and URIs."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_strictness() -> Stripped:
+    """Generate the enumeration of the validation modes."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Define how strictly the IRIs are validated.
+/// </summary>
+public enum Strictness
+{{
+{I}/// <summary>
+{I}/// Accept both absolute IRIs and relative references.
+{I}/// </summary>
+{I}Lenient,
+
+{I}/// <summary>
+{I}/// Accept only absolute IRIs which start with a scheme.
+{I}/// </summary>
+{I}Strict
+}}"""
+    )
+
+
+def _generate_is_allowed_character() -> Stripped:
+    """Generate the check whether a character may appear literally in an IRI."""
+    return Stripped(
+        f"""\
+private static bool IsAllowedCharacter(char character)
+{{
+{I}if (character <= ' ' || character == '\\u007F')
+{I}{{
+{II}return false;
+{I}}}
+
+{I}switch (character)
+{I}{{
+{II}case '<':
+{II}case '>':
+{II}case '"':
+{II}case '{{':
+{II}case '}}':
+{II}case '|':
+{II}case '\\\\':
+{II}case '^':
+{II}case '`':
+{III}return false;
+{II}default:
+{III}return true;
+{I}}}
+}}"""
+    )
+
+
+def _generate_has_scheme() -> Stripped:
+    """Generate the check whether an IRI starts with a scheme."""
+    return Stripped(
+        f"""\
+private static bool HasScheme(string text)
+{{
+{I}if (text.Length == 0 || !IsAsciiLetter(text[0]))
+{I}{{
+{II}return false;
+{I}}}
+
+{I}for (int i = 1; i < text.Length; i++)
+{I}{{
+{II}char character = text[i];
+{II}if (character == ':')
+{II}{{
+{III}return true;
+{II}}}
+
+{II}if (!IsAsciiLetter(character)
+{III}&& !(character >= '0' && character <= '9')
+{III}&& character != '+'
+{III}&& character != '-'
+{III}&& character != '.')
+{II}{{
+{III}return false;
+{II}}}
+{I}}}
+
+{I}return false;
+}}
+
+private static bool IsAsciiLetter(char character)
+{{
+{I}return (character >= 'a' && character <= 'z')
+{II}|| (character >= 'A' && character <= 'Z');
+}}"""
+    )
+
+
+def _generate_is_valid() -> Stripped:
+    """Generate the entry point of the validation."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Check whether <paramref name="text" /> is a valid IRI.
+/// </summary>
+/// <remarks>
+/// The characters which are forbidden by RFC 3987, such as spaces,
+/// control characters and <c>&lt;&gt;"{{}}|\\^`</c>, are rejected in all
+/// the modes. Every <c>%</c> must be followed by two hexadecimal digits.
+/// </remarks>
+/// <param name="text">to be checked</param>
+/// <param name="strictness">how strictly the IRI is validated</param>
+/// <returns>true if the IRI is valid</returns>
+public static bool IsValid(
+{I}string text,
+{I}Strictness strictness = Strictness.Strict)
+{{
+{I}for (int i = 0; i < text.Length; i++)
+{I}{{
+{II}char character = text[i];
+
+{II}if (character == '%')
+{II}{{
+{III}if (i + 2 >= text.Length
+{III}{I}|| !System.Uri.IsHexDigit(text[i + 1])
+{III}{I}|| !System.Uri.IsHexDigit(text[i + 2]))
+{III}{{
+{III}{I}return false;
+{III}}}
+
+{III}i += 2;
+{III}continue;
+{II}}}
+
+{II}if (!IsAllowedCharacter(character))
+{II}{{
+{III}return false;
+{II}}}
+{I}}}
+
+{I}return strictness == Strictness.Lenient || HasScheme(text);
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(namespace: csharp_common.NamespaceIdentifier) -> str:
+    """
+    Generate the C# code for validating IRIs and URIs.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    iri_validation_blocks = [
+        _generate_strictness(),
+        _generate_is_allowed_character(),
+        _generate_has_scheme(),
+        _generate_is_valid(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Validate IRIs and URIs uniformly.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// To use this validator instead of the permissive pattern in the verification,
+{I}/// provide the snippet <c>Verification/matches_xs_any_uri.cs</c>:
+{I}/// <code>
+{I}/// public static bool MatchesXsAnyUri(string text)
+{I}/// {{
+{I}///     return IriValidation.IsValid(text, IriValidation.Strictness.Lenient);
+{I}/// }}
+{I}/// </code>
+{I}/// </remarks>
+{I}public static class IriValidation
+{I}{{
+"""
+    )
+
+    for i, iri_validation_block in enumerate(iri_validation_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(iri_validation_block, II))
+
+    writer.write(f"\n{I}}}  // public static class IriValidation")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -0,0 +1,4 @@
+"""Generate C# code for streaming instances as JSON Lines."""
+from aas_core_codegen.csharp.json_lines import _generate
+
+generate = _generate.generate
@@ -0,0 +1,207 @@
+"""Generate C# code for streaming instances as JSON Lines."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_result() -> Stripped:
+    """Generate the container of the outcome of a single line."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Represent the outcome of reading a single line.
+/// </summary>
+/// <remarks>
+/// Exactly one of <see cref="Instance" /> and <see cref="Error" /> is set.
+/// </remarks>
+public class Result<T> where T : class
+{{
+{I}/// <summary>
+{I}/// 1-based number of the line in the input
+{I}/// </summary>
+{I}public int LineNumber {{ get; }}
+
+{I}/// <summary>
+{I}/// Deserialized instance, if the line could be read
+{I}/// </summary>
+{I}public T? Instance {{ get; }}
+
+{I}/// <summary>
+{I}/// Description of the error, if the line could not be read
+{I}/// </summary>
+{I}public string? Error {{ get; }}
+
+{I}public Result(int lineNumber, T? instance, string? error)
+{I}{{
+{II}LineNumber = lineNumber;
+{II}Instance = instance;
+{II}Error = error;
+{I}}}
+}}"""
+    )
+
+
+def _generate_read() -> Stripped:
+    """Generate the functions which read the instances line by line."""
+    return Stripped(
+        f"""\
+private static Result<T> ReadLine<T>(
+{I}int lineNumber,
+{I}string line,
+{I}System.Func<Nodes.JsonNode, T> deserialize) where T : class
+{{
+{I}Nodes.JsonNode? node;
+{I}try
+{I}{{
+{II}node = Nodes.JsonNode.Parse(line);
+{I}}}
+{I}catch (System.Text.Json.JsonException exception)
+{I}{{
+{II}return new Result<T>(lineNumber, null, exception.Message);
+{I}}}
+
+{I}if (node == null)
+{I}{{
+{II}return new Result<T>(
+{III}lineNumber, null, "Expected a JSON object, but got null");
+{I}}}
+
+{I}try
+{I}{{
+{II}return new Result<T>(lineNumber, deserialize(node), null);
+{I}}}
+{I}catch (Jsonization.Exception exception)
+{I}{{
+{II}return new Result<T>(lineNumber, null, exception.Message);
+{I}}}
+}}
+
+/// <summary>
+/// Read the instances from <paramref name="reader" />, one JSON object per line.
+/// </summary>
+/// <remarks>
+/// An invalid line does not stop the reading, but is reported as
+/// a result with an error so that the bulk imports can skip it.
+/// The empty lines are ignored.
+/// </remarks>
+/// <param name="reader">to read the lines from</param>
+/// <param name="deserialize">
+/// deserialize a JSON node, <em>e.g.</em>, one of
+/// the <c>Jsonization.Deserialize.*From</c> functions
+/// </param>
+public static IEnumerable<Result<T>> Read<T>(
+{I}System.IO.TextReader reader,
+{I}System.Func<Nodes.JsonNode, T> deserialize) where T : class
+{{
+{I}int lineNumber = 0;
+{I}string? line;
+{I}while ((line = reader.ReadLine()) != null)
+{I}{{
+{II}lineNumber++;
+
+{II}if (line.Trim().Length == 0)
+{II}{{
+{III}continue;
+{II}}}
+
+{II}yield return ReadLine(lineNumber, line, deserialize);
+{I}}}
+}}"""
+    )
+
+
+def _generate_write() -> Stripped:
+    """Generate the function which writes the instances line by line."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Write the <paramref name="instances" /> to <paramref name="writer" />,
+/// one JSON object per line.
+/// </summary>
+public static void Write(
+{I}System.IO.TextWriter writer,
+{I}IEnumerable<Aas.IClass> instances)
+{{
+{I}foreach (var instance in instances)
+{I}{{
+{II}writer.Write(
+{III}Jsonization.Serialize.ToJsonObject(instance).ToJsonString());
+{II}writer.Write('\\n');
+{I}}}
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(namespace: csharp_common.NamespaceIdentifier) -> str:
+    """
+    Generate the C# code for streaming the instances as JSON Lines.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    json_lines_blocks = [
+        _generate_result(),
+        _generate_read(),
+        _generate_write(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Stream instances as JSON Lines (NDJSON) for bulk import and export.
+{I}/// </summary>
+{I}public static class JsonLines
+{I}{{
+"""
+    )
+
+    for i, json_lines_block in enumerate(json_lines_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(json_lines_block, II))
+
+    writer.write(f"\n{I}}}  // public static class JsonLines")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using Nodes = System.Text.Json.Nodes;
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -721,7 +721,10 @@ internal static string? StringFrom(
 {III}"Expected a string, but got a null");
 {II}return null;
 {I}}}
-{I}return result;
+{I}var intern = Deserialize.Intern;
+{I}return (intern != null)
+{II}? intern(result)
+{II}: result;
 }}"""
         ),
         Stripped(
@@ -902,7 +905,20 @@ def _generate_deserialize(
     symbol_table: intermediate.SymbolTable,
 ) -> Stripped:
     """Generate the deserializer with a deserialization method for each class."""
-    blocks = []  # type: List[Stripped]
+    blocks = [
+        Stripped(
+            """\
+/// <summary>
+/// If set, intern the deserialized strings with this function.
+/// </summary>
+/// <remarks>
+/// Large data sets often repeat the same strings. Plug in, for example,
+/// <see cref="string.Intern" /> or your own pool so that all the occurrences
+/// of a string share a single instance.
+/// </remarks>
+public static System.Func<string, string>? Intern { get; set; }"""
+        )
+    ]  # type: List[Stripped]
     for our_type in symbol_table.our_types:
         if isinstance(our_type, intermediate.Enumeration):
             blocks.append(
@@ -0,0 +1,4 @@
+"""Generate C# code for handling BCP 47 language tags."""
+from aas_core_codegen.csharp.language_tags import _generate
+
+generate = _generate.generate
@@ -0,0 +1,227 @@
+"""Generate C# code for handling BCP 47 language tags."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II, INDENT3 as III
+
+
+def _generate_ascii_case_mapping() -> Stripped:
+    """Generate the case mapping restricted to the ASCII letters."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Map the ASCII letters of <paramref name="text" /> to lower case.
+/// </summary>
+/// <remarks>
+/// The subtags consist only of ASCII letters and digits. We leave all
+/// the other characters as they are since the culture-invariant case mapping
+/// would turn some of them into ASCII letters, e.g., the Kelvin sign into
+/// <c>k</c>.
+/// </remarks>
+private static string ToLowerAscii(string text)
+{{
+{I}var chars = text.ToCharArray();
+{I}for (int i = 0; i < chars.Length; i++)
+{I}{{
+{II}if (chars[i] >= 'A' && chars[i] <= 'Z')
+{II}{{
+{III}chars[i] = (char)(chars[i] + ('a' - 'A'));
+{II}}}
+{I}}}
+
+{I}return new string(chars);
+}}
+
+/// <summary>
+/// Map the ASCII letters of <paramref name="text" /> to upper case.
+/// </summary>
+/// <remarks>
+/// See <see cref="ToLowerAscii" /> why we map only the ASCII letters.
+/// </remarks>
+private static string ToUpperAscii(string text)
+{{
+{I}var chars = text.ToCharArray();
+{I}for (int i = 0; i < chars.Length; i++)
+{I}{{
+{II}if (chars[i] >= 'a' && chars[i] <= 'z')
+{II}{{
+{III}chars[i] = (char)(chars[i] - ('a' - 'A'));
+{II}}}
+{I}}}
+
+{I}return new string(chars);
+}}"""
+    )
+
+
+def _generate_normalize() -> Stripped:
+    """Generate the case folding of a language tag."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Normalize the case of the language <paramref name="tag" /> as recommended
+/// in RFC 5646, e.g., <c>EN-latn-us</c> becomes <c>en-Latn-US</c>.
+/// </summary>
+/// <remarks>
+/// The language tags are case-insensitive. The regions are written in
+/// upper case, the scripts in title case and all the other subtags in
+/// lower case. The subtags after a singleton, such as the extensions and
+/// the private use, are all written in lower case.
+/// </remarks>
+public static string Normalize(string tag)
+{{
+{I}string[] subtags = tag.Split('-');
+
+{I}bool afterSingleton = false;
+{I}for (int i = 0; i < subtags.Length; i++)
+{I}{{
+{II}string subtag = ToLowerAscii(subtags[i]);
+
+{II}if (i > 0 && !afterSingleton)
+{II}{{
+{III}if (subtag.Length == 2)
+{III}{{
+{III}{I}subtag = ToUpperAscii(subtag);
+{III}}}
+{III}else if (subtag.Length == 4 && subtag[0] >= 'a' && subtag[0] <= 'z')
+{III}{{
+{III}{I}subtag = ToUpperAscii(subtag.Substring(0, 1)) + subtag.Substring(1);
+{III}}}
+{II}}}
+
+{II}if (subtag.Length == 1)
+{II}{{
+{III}afterSingleton = true;
+{II}}}
+
+{II}subtags[i] = subtag;
+{I}}}
+
+{I}return string.Join("-", subtags);
+}}"""
+    )
+
+
+def _generate_primary_language() -> Stripped:
+    """Generate the extraction of the primary language subtag."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Extract the primary language subtag of the language <paramref name="tag" />
+/// in lower case, e.g., <c>de</c> for <c>de-CH</c>.
+/// </summary>
+public static string PrimaryLanguage(string tag)
+{{
+{I}int end = tag.IndexOf('-');
+{I}return ToLowerAscii(end < 0 ? tag : tag.Substring(0, end));
+}}"""
+    )
+
+
+def _generate_matches() -> Stripped:
+    """Generate the basic filtering of language tags."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Check whether the language <paramref name="tag" /> matches
+/// the language <paramref name="range" /> according to the basic filtering
+/// of RFC 4647.
+/// </summary>
+/// <remarks>
+/// For example, the range <c>de</c> matches <c>de</c> and <c>de-CH</c>,
+/// but not <c>dex</c>. The range <c>*</c> matches all the tags.
+///
+/// Only the ASCII letters are compared case-insensitively.
+/// </remarks>
+public static bool Matches(string tag, string range)
+{{
+{I}if (range == "*")
+{I}{{
+{II}return true;
+{I}}}
+
+{I}string lowerTag = ToLowerAscii(tag);
+{I}string lowerRange = ToLowerAscii(range);
+
+{I}if (lowerTag.Length == lowerRange.Length)
+{I}{{
+{II}return string.Equals(
+{III}lowerTag, lowerRange, System.StringComparison.Ordinal);
+{I}}}
+
+{I}return lowerTag.Length > lowerRange.Length
+{II}&& lowerTag[lowerRange.Length] == '-'
+{II}&& lowerTag.StartsWith(lowerRange, System.StringComparison.Ordinal);
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(namespace: csharp_common.NamespaceIdentifier) -> str:
+    """
+    Generate the C# code for handling BCP 47 language tags.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    language_tags_blocks = [
+        _generate_ascii_case_mapping(),
+        _generate_normalize(),
+        _generate_primary_language(),
+        _generate_matches(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Handle the BCP 47 language tags of the multi-language strings.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// The tags are assumed to be already verified to be well-formed.
+{I}/// </remarks>
+{I}public static class LanguageTags
+{I}{{
+"""
+    )
+
+    for i, language_tags_block in enumerate(language_tags_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(language_tags_block, II))
+
+    writer.write(f"\n{I}}}  // public static class LanguageTags")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
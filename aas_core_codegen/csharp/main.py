@@ -1,5 +1,5 @@
 """Generate C# code to handle asset administration shells based on the meta-model."""
-from typing import TextIO
+from typing import TextIO, Optional
 
 from aas_core_codegen import specific_implementations, run, intermediate
 from aas_core_codegen.csharp import (
@@ -12,9 +12,47 @@ from aas_core_codegen.csharp import (
     stringification as csharp_stringification,
     jsonization as csharp_jsonization,
     xmlization as csharp_xmlization,
+    digestion as csharp_digestion,
+    canonicalization as csharp_canonicalization,
+    language_tags as csharp_language_tags,
+    iri_validation as csharp_iri_validation,
+    json_lines as csharp_json_lines,
+    fuzzing as csharp_fuzzing,
+    redaction as csharp_redaction,
+    access_control as csharp_access_control,
+    instrumentation as csharp_instrumentation,
+    signing as csharp_signing,
+    statistics as csharp_statistics,
+    factories as csharp_factories,
+    ide_snippets as csharp_ide_snippets,
+    public_api as csharp_public_api,
 )
 
 
+def _strip_doc_comments(code: str) -> str:
+    """Remove the documentation comments from the C# ``code``."""
+    return "".join(
+        line
+        for line in code.splitlines(keepends=True)
+        if not line.lstrip().startswith("///")
+    )
+
+
+def _optimize_for_size(context: run.Context) -> None:
+    """
+    Remove the documentation comments from the generated code.
+
+    We touch only the C# files written in this generation so that the files
+    which the user put in the output directory are left as-is.
+    """
+    for pth in context.written_paths:
+        if pth.suffix != ".cs":
+            continue
+
+        code = pth.read_text(encoding="utf-8")
+        run.write_text(path=pth, text=_strip_doc_comments(code))
+
+
 def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     """Generate the code."""
     verified_ir_table, errors = csharp_structure.verify(
@@ -108,7 +146,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "types.cs"
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the C# structures to {pth}",
@@ -138,7 +176,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "visitation.cs"
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the visitation C# code to {pth}",
@@ -168,10 +206,10 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     assert code is not None
 
     pth = context.output_dir / "constants.cs"
-    pth.parent.mkdir(exist_ok=True)
+    run.extended_length_path(pth.parent).mkdir(exist_ok=True)
 
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the constants in the C# code to {pth}",
@@ -189,7 +227,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
         verification_functions=verified_ir_table.verification_functions,
     )
 
-    if errors is not None:
+    if verify_errors is not None:
         run.write_error_report(
             message="Failed to verify the C#-specific structures",
             errors=verify_errors,
@@ -216,7 +254,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "verification.cs"
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the verification C# code to {pth}",
@@ -232,10 +270,10 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     code = csharp_reporting.generate(namespace=namespace)
 
     pth = context.output_dir / "reporting.cs"
-    pth.parent.mkdir(exist_ok=True)
+    run.extended_length_path(pth.parent).mkdir(exist_ok=True)
 
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the reporting C# code to {pth}",
@@ -249,7 +287,9 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     # region Stringification
 
     code, errors = csharp_stringification.generate(
-        symbol_table=context.symbol_table, namespace=namespace
+        symbol_table=context.symbol_table,
+        namespace=namespace,
+        lenient=context.lenient_enum_parsing,
     )
 
     if errors is not None:
@@ -264,10 +304,10 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     assert code is not None
 
     pth = context.output_dir / "stringification.cs"
-    pth.parent.mkdir(exist_ok=True)
+    run.extended_length_path(pth.parent).mkdir(exist_ok=True)
 
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the stringification C# code to {pth}",
@@ -298,10 +338,10 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     assert code is not None
 
     pth = context.output_dir / "jsonization.cs"
-    pth.parent.mkdir(exist_ok=True)
+    run.extended_length_path(pth.parent).mkdir(exist_ok=True)
 
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the jsonization C# code to {pth}",
@@ -332,10 +372,10 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
     assert code is not None
 
     pth = context.output_dir / "xmlization.cs"
-    pth.parent.mkdir(exist_ok=True)
+    run.extended_length_path(pth.parent).mkdir(exist_ok=True)
 
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the xmlization C# code to {pth}",
@@ -346,5 +386,405 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     # endregion
 
-    stdout.write(f"Code generated to: {context.output_dir}\n")
+    # region JSON Lines
+
+    if run.Extra.JSON_LINES in context.extras:
+        code = csharp_json_lines.generate(namespace=namespace)
+
+        pth = context.output_dir / "json_lines.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the JSON Lines C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Fuzzing
+
+    if run.Extra.FUZZING in context.extras:
+        code = csharp_fuzzing.generate(
+            symbol_table=context.symbol_table, namespace=namespace
+        )
+
+        pth = context.output_dir / "fuzzing.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the fuzzing C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Digestion
+
+    # The signing builds on the digestion.
+    if (
+        run.Extra.DIGESTION in context.extras
+        or run.Extra.SIGNING in context.extras
+    ):
+        code, errors = csharp_digestion.generate(
+            symbol_table=context.symbol_table,
+            namespace=namespace,
+            spec_impls=context.spec_impls,
+        )
+
+        if errors is not None:
+            run.write_error_report(
+                message=f"Failed to generate the digestion C# code "
+                f"based on {context.model_path}",
+                errors=[
+                    context.lineno_columner.error_message(error) for error in errors
+                ],
+                stderr=stderr,
+            )
+            return 1
+
+        assert code is not None
+
+        pth = context.output_dir / "digestion.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the digestion C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Canonicalization
+
+    if run.Extra.CANONICALIZATION in context.extras:
+        code = csharp_canonicalization.generate(namespace=namespace)
+
+        pth = context.output_dir / "canonicalization.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the canonicalization C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Language tags
+
+    if run.Extra.LANGUAGE_TAGS in context.extras:
+        code = csharp_language_tags.generate(namespace=namespace)
+
+        pth = context.output_dir / "language_tags.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the language-tags C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region IRI validation
+
+    if run.Extra.IRI_VALIDATION in context.extras:
+        code = csharp_iri_validation.generate(namespace=namespace)
+
+        pth = context.output_dir / "iri_validation.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the IRI-validation C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Signing
+
+    if run.Extra.SIGNING in context.extras:
+        code = csharp_signing.generate(namespace=namespace)
+
+        pth = context.output_dir / "signing.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the signing C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Redaction
+
+    # The access control builds on the redaction.
+    if (
+        run.Extra.REDACTION in context.extras
+        or run.Extra.ACCESS_CONTROL in context.extras
+    ):
+        code = csharp_redaction.generate(
+            symbol_table=context.symbol_table, namespace=namespace
+        )
+
+        pth = context.output_dir / "redaction.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the redaction C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Access control
+
+    if run.Extra.ACCESS_CONTROL in context.extras:
+        code, errors = csharp_access_control.generate(
+            symbol_table=context.symbol_table, namespace=namespace
+        )
+
+        if errors is not None:
+            run.write_error_report(
+                message=f"Failed to generate the access-control C# code "
+                f"based on {context.model_path}",
+                errors=[
+                    context.lineno_columner.error_message(error) for error in errors
+                ],
+                stderr=stderr,
+            )
+            return 1
+
+        assert code is not None
+
+        pth = context.output_dir / "access_control.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the access-control C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Instrumentation
+
+    if run.Extra.INSTRUMENTATION in context.extras:
+        code = csharp_instrumentation.generate(
+            symbol_table=context.symbol_table, namespace=namespace
+        )
+
+        pth = context.output_dir / "instrumentation.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the instrumentation C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Statistics
+
+    if run.Extra.STATISTICS in context.extras:
+        code = csharp_statistics.generate(
+            symbol_table=context.symbol_table, namespace=namespace
+        )
+
+        pth = context.output_dir / "statistics.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the statistics C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region Factories
+
+    if run.Extra.FACTORIES in context.extras:
+        code, errors = csharp_factories.generate(
+            symbol_table=context.symbol_table,
+            namespace=namespace,
+            spec_impls=context.spec_impls,
+        )
+
+        if errors is not None:
+            run.write_error_report(
+                message=f"Failed to generate the factories C# code "
+                f"based on {context.model_path}",
+                errors=[
+                    context.lineno_columner.error_message(error) for error in errors
+                ],
+                stderr=stderr,
+            )
+            return 1
+
+        assert code is not None
+
+        pth = context.output_dir / "factories.cs"
+        run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+        try:
+            context.write_text(path=pth, text=code)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the factories C# code to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    # region IDE snippets
+
+    if run.Extra.IDE_SNIPPETS in context.extras:
+        for text, name in [
+            (
+                csharp_ide_snippets.generate_vs_code(symbol_table=context.symbol_table),
+                "csharp.code-snippets",
+            ),
+            (
+                csharp_ide_snippets.generate_live_templates(
+                    symbol_table=context.symbol_table
+                ),
+                "csharp_live_templates.xml",
+            ),
+        ]:
+            pth = context.output_dir / "ide_snippets" / name
+            run.extended_length_path(pth.parent).mkdir(exist_ok=True)
+
+            try:
+                context.write_text(path=pth, text=text)
+            except Exception as exception:
+                run.write_error_report(
+                    message=f"Failed to write the IDE snippets to {pth}",
+                    errors=[str(exception)],
+                    stderr=stderr,
+                )
+                return 1
+
+    # endregion
+
+    # region Public API
+
+    if run.Extra.PUBLIC_API in context.extras:
+        # We compare against the public API of the previous generation, if any, so
+        # that the maintainers of an SDK see what changed when they re-generate into
+        # their repository, e.g., to decide on the next semantic version.
+        public_api = csharp_public_api.collect(symbol_table=context.symbol_table)
+
+        pth = context.output_dir / "public_api.json"
+
+        previous_public_api = None  # type: Optional[csharp_public_api.PublicApi]
+        if pth.exists():
+            try:
+                previous_public_api = csharp_public_api.load(
+                    pth.read_text(encoding="utf-8")
+                )
+            except Exception as exception:
+                run.write_error_report(
+                    message=f"Failed to read the public API of the previous generation "
+                    f"from {pth}",
+                    errors=[str(exception)],
+                    stderr=stderr,
+                )
+                return 1
+
+        try:
+            context.write_text(path=pth, text=csharp_public_api.dump(public_api) + "\n")
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the public API to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+        if previous_public_api is not None:
+            changes = csharp_public_api.compare(old=previous_public_api, new=public_api)
+            if len(changes) > 0:
+                context.logger.info(
+                    "The public API changed since the previous generation:\n"
+                    + "\n".join(f"  {change}" for change in changes),
+                    public_api_changes=[change.to_jsonable() for change in changes],
+                )
+
+    # endregion
+
+    # region Optimize for size
+
+    if context.optimize_for_size:
+        try:
+            _optimize_for_size(context=context)
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to optimize the generated code for size "
+                f"in {context.output_dir}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    # endregion
+
+    context.logger.info(
+        f"Code generated to: {context.output_dir}",
+        output_dir=str(context.output_dir),
+    )
     return 0
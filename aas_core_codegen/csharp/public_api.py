@@ -0,0 +1,307 @@
+"""Collect the public API of the generated C# code and compare its versions."""
+import collections
+import enum
+import json
+from typing import Mapping, MutableMapping, List, Optional, Sequence
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Stripped, assert_never
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+
+# We bump this version whenever the structure of the dumped public API changes so
+# that the previous dumps are not compared against incompatible ones.
+_FORMAT_VERSION = 1
+
+#: Map the qualified public symbols to their signatures
+PublicApi = Mapping[str, str]
+
+
+def _signature(
+    arguments: Sequence[intermediate.Argument],
+    returns: Optional[intermediate.TypeAnnotationUnion],
+) -> str:
+    """Render the signature of a method or a function as C# code."""
+    args = ", ".join(
+        f"{csharp_common.generate_type(arg.type_annotation)} "
+        f"{csharp_naming.argument_name(arg.name)}"
+        for arg in arguments
+    )
+
+    returns_type = (
+        csharp_common.generate_type(returns) if returns is not None else "void"
+    )
+
+    return f"{returns_type} ({args})"
+
+
+def _collect_renamed_property_shim(
+    prop: intermediate.Property, container: str, into: MutableMapping[str, str]
+) -> None:
+    """Collect the deprecated property forwarding the previous name to ``prop``."""
+    if prop.description is None or prop.description.renamed_from is None:
+        return
+
+    old_name = csharp_naming.property_name(prop.description.renamed_from)
+    into[f"{container}.{old_name}"] = csharp_common.generate_type(
+        prop.type_annotation
+    )
+
+
+def collect(symbol_table: intermediate.SymbolTable) -> PublicApi:
+    """Collect the public symbols of the generated C# code with their signatures."""
+    result = collections.OrderedDict()  # type: MutableMapping[str, str]
+
+    for something in csharp_common.over_enumerations_classes_and_interfaces(
+        symbol_table
+    ):
+        if isinstance(something, intermediate.Enumeration):
+            name = csharp_naming.enum_name(something.name)
+            result[name] = "enum"
+
+            for literal in something.literals:
+                literal_name = csharp_naming.enum_literal_name(literal.name)
+                result[f"{name}.{literal_name}"] = (
+                    f"literal {json.dumps(literal.value)}"
+                )
+
+        elif isinstance(something, intermediate.Interface):
+            name = csharp_naming.interface_name(something.name)
+            result[name] = "interface"
+
+            for prop in something.properties:
+                prop_name = csharp_naming.property_name(prop.name)
+                result[f"{name}.{prop_name}"] = csharp_common.generate_type(
+                    prop.type_annotation
+                )
+
+                _collect_renamed_property_shim(prop=prop, container=name, into=result)
+
+            for signature in something.signatures:
+                method_name = csharp_naming.method_name(signature.name)
+                result[f"{name}.{method_name}"] = _signature(
+                    signature.arguments, signature.returns
+                )
+
+        elif isinstance(something, intermediate.ConcreteClass):
+            name = csharp_naming.class_name(something.name)
+            result[name] = "class"
+
+            for prop in something.properties:
+                prop_name = csharp_naming.property_name(prop.name)
+                result[f"{name}.{prop_name}"] = csharp_common.generate_type(
+                    prop.type_annotation
+                )
+
+                _collect_renamed_property_shim(prop=prop, container=name, into=result)
+
+            for method in something.methods:
+                method_name = csharp_naming.method_name(method.name)
+                result[f"{name}.{method_name}"] = _signature(
+                    method.arguments, method.returns
+                )
+
+            result[f"{name}.{name}"] = _signature(something.constructor.arguments, None)
+
+            if (
+                something.description is not None
+                and something.description.renamed_from is not None
+            ):
+                old_name = csharp_naming.class_name(something.description.renamed_from)
+                result[old_name] = "class"
+                result[f"{old_name}.{old_name}"] = _signature(
+                    something.constructor.arguments, None
+                )
+
+        else:
+            assert_never(something)
+
+    for constant in symbol_table.constants:
+        result[f"Constants.{csharp_naming.property_name(constant.name)}"] = "constant"
+
+    for verification in symbol_table.verification_functions:
+        method_name = csharp_naming.method_name(verification.name)
+        result[f"Verification.{method_name}"] = _signature(
+            verification.arguments, verification.returns
+        )
+
+    return result
+
+
+def dump(public_api: PublicApi) -> Stripped:
+    """Serialize the ``public_api`` to JSON."""
+    return Stripped(
+        json.dumps(
+            collections.OrderedDict(
+                [("formatVersion", _FORMAT_VERSION), ("symbols", public_api)]
+            ),
+            indent=2,
+        )
+    )
+
+
+def load(text: str) -> Optional[PublicApi]:
+    """
+    Parse the public API dumped with :py:func:`dump`.
+
+    Return None if the ``text`` has been dumped in an incompatible format.
+    """
+    jsonable = json.loads(text)
+    if (
+        not isinstance(jsonable, dict)
+        or jsonable.get("formatVersion", None) != _FORMAT_VERSION
+    ):
+        return None
+
+    return collections.OrderedDict(jsonable["symbols"])
+
+
+class ChangeKind(enum.Enum):
+    """List the kinds of changes of a public symbol."""
+
+    #: The symbol has been added.
+    ADDED = "added"
+
+    #: The symbol has been removed.
+    REMOVED = "removed"
+
+    #: The signature of the symbol has changed.
+    CHANGED = "changed"
+
+    #: The symbol has been renamed, but kept its signature.
+    RENAMED = "renamed"
+
+
+class Change:
+    """Represent a change of a public symbol between two versions."""
+
+    def __init__(
+        self,
+        kind: ChangeKind,
+        symbol: str,
+        old_symbol: Optional[str],
+        old_signature: Optional[str],
+        new_signature: Optional[str],
+    ) -> None:
+        """Initialize with the given values."""
+        self.kind = kind
+        self.symbol = symbol
+        self.old_symbol = old_symbol
+        self.old_signature = old_signature
+        self.new_signature = new_signature
+
+    def is_breaking(self) -> bool:
+        """Check whether the change breaks the code depending on the symbol."""
+        return self.kind is not ChangeKind.ADDED
+
+    def __str__(self) -> str:
+        """Render the change for humans."""
+        if self.kind is ChangeKind.ADDED:
+            return f"added: {self.symbol}: {self.new_signature}"
+        elif self.kind is ChangeKind.REMOVED:
+            return f"removed: {self.symbol}: {self.old_signature}"
+        elif self.kind is ChangeKind.CHANGED:
+            return (
+                f"changed: {self.symbol}: "
+                f"{self.old_signature} -> {self.new_signature}"
+            )
+        elif self.kind is ChangeKind.RENAMED:
+            return f"renamed: {self.old_symbol} -> {self.symbol}"
+        else:
+            assert_never(self.kind)
+
+        raise AssertionError("Should not have gotten here")
+
+    def to_jsonable(self) -> MutableMapping[str, Optional[str]]:
+        """Convert the change to a JSON-able mapping."""
+        return collections.OrderedDict(
+            [
+                ("kind", self.kind.value),
+                ("symbol", self.symbol),
+                ("oldSymbol", self.old_symbol),
+                ("oldSignature", self.old_signature),
+                ("newSignature", self.new_signature),
+            ]
+        )
+
+
+def _container(symbol: str) -> str:
+    """Determine the container of the ``symbol``, or an empty string if top-level."""
+    return symbol.rsplit(".", 1)[0] if "." in symbol else ""
+
+
+def compare(old: PublicApi, new: PublicApi) -> List[Change]:
+    """
+    Compare the ``old`` and the ``new`` public API.
+
+    A symbol is considered renamed if it is the only one removed from its container
+    and the only one added to it with the same signature.
+    """
+    removed = [symbol for symbol in old if symbol not in new]
+    added = [symbol for symbol in new if symbol not in old]
+
+    renamed_from = dict()  # type: MutableMapping[str, str]
+    for symbol in added:
+        candidates = [
+            old_symbol
+            for old_symbol in removed
+            if _container(old_symbol) == _container(symbol)
+            and old[old_symbol] == new[symbol]
+        ]
+
+        competitors = [
+            other
+            for other in added
+            if _container(other) == _container(symbol) and new[other] == new[symbol]
+        ]
+
+        if len(candidates) == 1 and len(competitors) == 1:
+            renamed_from[symbol] = candidates[0]
+
+    renamed_set = set(renamed_from.values())
+
+    changes = []  # type: List[Change]
+    for symbol in removed:
+        if symbol not in renamed_set:
+            changes.append(
+                Change(
+                    kind=ChangeKind.REMOVED,
+                    symbol=symbol,
+                    old_symbol=None,
+                    old_signature=old[symbol],
+                    new_signature=None,
+                )
+            )
+
+    for symbol, signature in new.items():
+        if symbol in renamed_from:
+            changes.append(
+                Change(
+                    kind=ChangeKind.RENAMED,
+                    symbol=symbol,
+                    old_symbol=renamed_from[symbol],
+                    old_signature=old[renamed_from[symbol]],
+                    new_signature=signature,
+                )
+            )
+        elif symbol not in old:
+            changes.append(
+                Change(
+                    kind=ChangeKind.ADDED,
+                    symbol=symbol,
+                    old_symbol=None,
+                    old_signature=None,
+                    new_signature=signature,
+                )
+            )
+        elif old[symbol] != signature:
+            changes.append(
+                Change(
+                    kind=ChangeKind.CHANGED,
+                    symbol=symbol,
+                    old_symbol=None,
+                    old_signature=old[symbol],
+                    new_signature=signature,
+                )
+            )
+
+    return changes
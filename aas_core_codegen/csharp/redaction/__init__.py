@@ -0,0 +1,5 @@
+"""Generate C# code for stripping properties and instances before export."""
+from aas_core_codegen.csharp.redaction import _generate
+
+property_literal = _generate.property_literal
+generate = _generate.generate
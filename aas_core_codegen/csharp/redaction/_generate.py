@@ -0,0 +1,386 @@
+"""Generate C# code for stripping properties and instances before export."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Identifier, Stripped, indent_but_first_line
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import (
+    INDENT as I,
+    INDENT2 as II,
+    INDENT3 as III,
+)
+
+
+def property_literal(prop: intermediate.Property) -> Identifier:
+    """Generate the name of the literal of :code:`Redaction.Property` for ``prop``."""
+    return csharp_naming.enum_literal_name(
+        Identifier(f"{prop.specified_for.name}_{prop.name}")
+    )
+
+
+def _generate_property_enum(symbol_table: intermediate.SymbolTable) -> Stripped:
+    """Generate the enumeration of the properties which can be stripped."""
+    literals = []  # type: List[Identifier]
+    for our_type in symbol_table.our_types:
+        if not isinstance(
+            our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)
+        ):
+            continue
+
+        for prop in our_type.properties:
+            if prop.specified_for is not our_type:
+                continue
+
+            if not isinstance(
+                prop.type_annotation, intermediate.OptionalTypeAnnotation
+            ):
+                continue
+
+            literals.append(property_literal(prop))
+
+    writer = io.StringIO()
+    writer.write(
+        """\
+/// <summary>
+/// Enumerate the properties which can be stripped.
+/// </summary>
+/// <remarks>
+/// The literals are named after the class which declares the property so that
+/// stripping an inherited property strips it from all the descendants.
+/// Only optional properties are listed as the required ones can not be
+/// stripped without breaking the instances.
+/// </remarks>
+public enum Property
+{
+"""
+    )
+
+    for i, literal in enumerate(literals):
+        if i > 0:
+            writer.write(",\n")
+        writer.write(f"{I}{literal}")
+
+    if len(literals) > 0:
+        writer.write("\n")
+
+    writer.write("}  // public enum Property")
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_filter_interface() -> Stripped:
+    """Generate the interface deciding what needs to be redacted."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Decide which properties and instances are to be redacted.
+/// </summary>
+public interface IFilter
+{{
+{I}/// <summary>
+{I}/// Check whether <paramref name="property" /> of <paramref name="that" />
+{I}/// needs to be stripped.
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// Only the properties which are set are checked.
+{I}/// </remarks>
+{I}bool Strips(Aas.IClass that, Property property);
+
+{I}/// <summary>
+{I}/// Check whether <paramref name="that" /> needs to be removed from
+{I}/// the list containing it.
+{I}/// </summary>
+{I}bool Excludes(Aas.IClass that);
+}}  // public interface IFilter"""
+    )
+
+
+def _generate_filter() -> Stripped:
+    """Generate the declarative filter specifying what needs to be redacted."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Specify declaratively which properties and instances are to be redacted.
+/// </summary>
+public class Filter : IFilter
+{{
+{I}private readonly HashSet<Property> _properties;
+{I}private readonly System.Predicate<Aas.IClass>? _instances;
+
+{I}/// <param name="properties">Properties to be stripped</param>
+{I}/// <param name="instances">
+{I}/// If set, the instances in lists satisfying this predicate are removed
+{I}/// </param>
+{I}public Filter(
+{II}IEnumerable<Property> properties,
+{II}System.Predicate<Aas.IClass>? instances = null)
+{I}{{
+{II}_properties = new HashSet<Property>(properties);
+{II}_instances = instances;
+{I}}}
+
+{I}/// <inheritdoc />
+{I}public bool Strips(Aas.IClass that, Property property)
+{I}{{
+{II}return _properties.Contains(property);
+{I}}}
+
+{I}/// <inheritdoc />
+{I}public bool Excludes(Aas.IClass that)
+{I}{{
+{II}return _instances != null && _instances(that);
+{I}}}
+}}  // public class Filter"""
+    )
+
+
+def _generate_type(type_annotation: intermediate.TypeAnnotationUnion) -> Stripped:
+    """
+    Generate the C# type for ``type_annotation`` qualified with the namespace.
+
+    We qualify our types so that they do not clash with :code:`Property`.
+    """
+    if isinstance(type_annotation, intermediate.OurTypeAnnotation) and isinstance(
+        type_annotation.our_type, (intermediate.Enumeration, intermediate.Class)
+    ):
+        return Stripped(f"Aas.{csharp_common.generate_type(type_annotation)}")
+
+    if isinstance(type_annotation, intermediate.ListTypeAnnotation):
+        return Stripped(f"List<{_generate_type(type_annotation.items)}>")
+
+    return csharp_common.generate_type(type_annotation)
+
+
+def _generate_copy_of_property(prop: intermediate.Property) -> Stripped:
+    """Generate the expression copying ``prop`` of ``that`` for the constructor."""
+    prop_name = csharp_naming.property_name(prop.name)
+    type_anno = intermediate.beneath_optional(prop.type_annotation)
+
+    if isinstance(type_anno, intermediate.OurTypeAnnotation) and isinstance(
+        type_anno.our_type, intermediate.Class
+    ):
+        copy = f"({_generate_type(type_anno)})Transform(that.{prop_name})"
+
+    elif isinstance(type_anno, intermediate.ListTypeAnnotation):
+        if isinstance(type_anno.items, intermediate.OurTypeAnnotation) and isinstance(
+            type_anno.items.our_type, intermediate.Class
+        ):
+            copy = f"TransformList(that.{prop_name})"
+        else:
+            copy = f"new {_generate_type(type_anno)}(that.{prop_name})"
+
+    else:
+        copy = f"that.{prop_name}"
+
+    if not isinstance(prop.type_annotation, intermediate.OptionalTypeAnnotation):
+        return Stripped(copy)
+
+    return Stripped(
+        f"""\
+that.{prop_name} != null && !_filter.Strips(that, Property.{property_literal(prop)})
+{I}? {copy}
+{I}: null"""
+    )
+
+
+def _generate_transform_for_class(cls: intermediate.ConcreteClass) -> Stripped:
+    """Generate the transform method which copies and redacts ``cls``."""
+    cls_name = csharp_naming.class_name(cls.name)
+
+    # fmt: off
+    assert (
+            set(prop.name for prop in cls.properties)
+            == set(arg.name for arg in cls.constructor.arguments)
+    ), (
+        f"Expected the properties to coincide with constructor arguments, "
+        f"but they do not for {cls.name!r}"
+    )
+    # fmt: on
+
+    if len(cls.constructor.arguments) == 0:
+        construction = Stripped(f"return new Aas.{cls_name}();")
+    else:
+        copies = [
+            _generate_copy_of_property(prop=cls.properties_by_name[arg.name])
+            for arg in cls.constructor.arguments
+        ]
+
+        writer = io.StringIO()
+        writer.write(f"return new Aas.{cls_name}(\n")
+        for i, copy in enumerate(copies):
+            writer.write(textwrap.indent(copy, I))
+            if i < len(copies) - 1:
+                writer.write(",\n")
+            else:
+                writer.write(");")
+
+        construction = Stripped(writer.getvalue())
+
+    return Stripped(
+        f"""\
+public override Aas.IClass Transform(Aas.{cls_name} that)
+{{
+{I}{indent_but_first_line(construction, I)}
+}}"""
+    )
+
+
+def _generate_redactor(symbol_table: intermediate.SymbolTable) -> Stripped:
+    """Generate the transformer which makes a redacted copy."""
+    blocks = [
+        Stripped("private readonly IFilter _filter;"),
+        Stripped(
+            f"""\
+public Redactor(IFilter filter)
+{{
+{I}_filter = filter;
+}}"""
+        ),
+        Stripped(
+            f"""\
+private List<T> TransformList<T>(List<T> that) where T : Aas.IClass
+{{
+{I}var result = new List<T>(that.Count);
+{I}foreach (var item in that)
+{I}{{
+{II}if (!_filter.Excludes(item))
+{II}{{
+{III}result.Add((T)Transform(item));
+{II}}}
+{I}}}
+
+{I}return result;
+}}"""
+        ),
+    ]  # type: List[Stripped]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(our_type, intermediate.ConcreteClass):
+            continue
+
+        blocks.append(_generate_transform_for_class(cls=our_type))
+
+    writer = io.StringIO()
+    writer.write(
+        """\
+/// <summary>
+/// Copy the instances while stripping the properties and removing the instances
+/// as given by the filter.
+/// </summary>
+private class Redactor : Visitation.AbstractTransformer<Aas.IClass>
+{
+"""
+    )
+
+    for i, block in enumerate(blocks):
+        if i > 0:
+            writer.write("\n\n")
+        writer.write(textwrap.indent(block, I))
+
+    writer.write("\n}  // private class Redactor")
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_redact() -> Stripped:
+    """Generate the entry point for the redaction."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Make a copy of <paramref name="that" /> and all its descendants redacted
+/// according to <paramref name="filter" />.
+/// </summary>
+/// <remarks>
+/// <para>
+/// The original instances are left untouched. The values of the primitive
+/// properties, including the byte arrays, are shared with the copy.
+/// </para>
+/// <para>
+/// The redacted instances are not necessarily valid anymore. For example,
+/// removing all the items of a required list breaks the constraint that
+/// the list must not be empty. Please re-verify the result if needed.
+/// </para>
+/// </remarks>
+public static T Redact<T>(T that, IFilter filter) where T : Aas.IClass
+{{
+{I}var redactor = new Redactor(filter);
+{I}return (T)redactor.Transform(that);
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+) -> str:
+    """
+    Generate the C# code for redacting the model instances.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    redaction_blocks = [
+        _generate_property_enum(symbol_table=symbol_table),
+        _generate_filter_interface(),
+        _generate_filter(),
+        _generate_redactor(symbol_table=symbol_table),
+        _generate_redact(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Strip properties and instances from the model, e.g., before sharing
+{I}/// the data with third parties.
+{I}/// </summary>
+{I}public static class Redaction
+{I}{{
+"""
+    )
+
+    for i, redaction_block in enumerate(redaction_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(redaction_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Redaction")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -0,0 +1,4 @@
+"""Generate C# code for signing and verifying the canonical form of instances."""
+from aas_core_codegen.csharp.signing import _generate
+
+generate = _generate.generate
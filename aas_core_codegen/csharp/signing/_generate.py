@@ -0,0 +1,360 @@
+"""Generate C# code for signing and verifying the canonical form of instances."""
+
+import io
+import textwrap
+from typing import List
+
+from icontract import ensure
+
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import common as csharp_common
+from aas_core_codegen.csharp.common import (
+    INDENT as I,
+    INDENT2 as II,
+    INDENT3 as III,
+)
+
+
+def _generate_signature() -> Stripped:
+    """Generate the container of a detached signature."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Contain a detached signature of a model instance.
+/// </summary>
+/// <remarks>
+/// <para>
+/// The signature is computed over the SHA-256 hash of
+/// <see cref="Digestion.CanonicalJson" />, so that it can be verified
+/// regardless of how the instance has been serialized in the meantime.
+/// </para>
+/// <para>
+/// Use <see cref="Signing.ToJsonObject" /> and
+/// <see cref="Signing.SignatureFrom" /> to
+/// transmit the signature alongside the instance.
+/// </para>
+/// </remarks>
+public class Signature
+{{
+{I}/// <summary>
+{I}/// Identifier of the signature algorithm such as <c>ES256</c>
+{I}/// </summary>
+{I}public string Algorithm {{ get; }}
+
+{I}/// <summary>
+{I}/// Identifier of the hash algorithm applied to the canonical form
+{I}/// </summary>
+{I}/// <remarks>
+{I}/// Only <c>SHA-256</c> is supported for verification. Other values can
+{I}/// come from signatures deserialized from other producers.
+{I}/// </remarks>
+{I}public string HashAlgorithm {{ get; }}
+
+{I}/// <summary>
+{I}/// Identifier of the signing key, if any
+{I}/// </summary>
+{I}public string? KeyId {{ get; }}
+
+{I}/// <summary>
+{I}/// Value of the signature
+{I}/// </summary>
+{I}public byte[] Value {{ get; }}
+
+{I}public Signature(
+{II}string algorithm,
+{II}byte[] value,
+{II}string? keyId = null,
+{II}string hashAlgorithm = "SHA-256")
+{I}{{
+{II}Algorithm = algorithm;
+{II}HashAlgorithm = hashAlgorithm;
+{II}Value = value;
+{II}KeyId = keyId;
+{I}}}
+}}"""
+    )
+
+
+def _generate_compute_hash() -> Stripped:
+    """Generate the function which hashes the canonical form of an instance."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Compute the SHA-256 hash of the canonical form of <paramref name="that" />.
+/// </summary>
+public static byte[] ComputeHash(Aas.IClass that)
+{{
+{I}byte[] canonical = Digestion.CanonicalJson(that);
+
+{I}using var sha256 = System.Security.Cryptography.SHA256.Create();
+{I}return sha256.ComputeHash(canonical);
+}}"""
+    )
+
+
+def _generate_sign() -> Stripped:
+    """Generate the functions which sign an instance."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Sign <paramref name="that" /> with a custom <paramref name="signHash" />.
+/// </summary>
+/// <remarks>
+/// Use this overload to plug in a hardware security module or
+/// a remote signing service.
+/// </remarks>
+/// <param name="that">instance to be signed</param>
+/// <param name="algorithm">identifier of the signature algorithm</param>
+/// <param name="signHash">sign the given hash and return the signature</param>
+/// <param name="keyId">identifier of the signing key, if any</param>
+public static Signature Sign(
+{I}Aas.IClass that,
+{I}string algorithm,
+{I}System.Func<byte[], byte[]> signHash,
+{I}string? keyId = null)
+{{
+{I}return new Signature(algorithm, signHash(ComputeHash(that)), keyId);
+}}
+
+/// <summary>
+/// Sign <paramref name="that" /> with the elliptic-curve <paramref name="key" />.
+/// </summary>
+/// <remarks>
+/// Only the keys on the curve P-256 are supported as the signature algorithm
+/// <c>ES256</c> prescribes SHA-256 which we use to hash the canonical form.
+/// </remarks>
+/// <exception cref="System.ArgumentException">
+/// Thrown if the <paramref name="key" /> is not on the curve P-256.
+/// </exception>
+public static Signature Sign(
+{I}Aas.IClass that,
+{I}System.Security.Cryptography.ECDsa key,
+{I}string? keyId = null)
+{{
+{I}if (key.KeySize != 256)
+{I}{{
+{II}throw new System.ArgumentException(
+{III}"Expected a key on the curve P-256 for the algorithm ES256, " +
+{III}$"but got a key of size {{key.KeySize}}",
+{III}nameof(key));
+{I}}}
+
+{I}return Sign(that, "ES256", key.SignHash, keyId);
+}}"""
+    )
+
+
+def _generate_verify() -> Stripped:
+    """Generate the functions which verify the signature of an instance."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Verify the <paramref name="signature" /> of <paramref name="that" />
+/// with a custom <paramref name="verifyHash" />.
+/// </summary>
+/// <param name="that">signed instance</param>
+/// <param name="signature">detached signature of the instance</param>
+/// <param name="verifyHash">
+/// check the signature value (second argument) against the hash (first argument)
+/// </param>
+/// <returns>true if the signature is valid</returns>
+public static bool Verify(
+{I}Aas.IClass that,
+{I}Signature signature,
+{I}System.Func<byte[], byte[], bool> verifyHash)
+{{
+{I}if (signature.HashAlgorithm != "SHA-256")
+{I}{{
+{II}return false;
+{I}}}
+
+{I}return verifyHash(ComputeHash(that), signature.Value);
+}}
+
+/// <summary>
+/// Verify the <paramref name="signature" /> of <paramref name="that" />
+/// with the elliptic-curve <paramref name="key" />.
+/// </summary>
+/// <remarks>
+/// Only the keys on the curve P-256 are supported, see
+/// <see cref="Sign(Aas.IClass, System.Security.Cryptography.ECDsa, string?)" />.
+/// </remarks>
+/// <returns>true if the signature is valid</returns>
+public static bool Verify(
+{I}Aas.IClass that,
+{I}Signature signature,
+{I}System.Security.Cryptography.ECDsa key)
+{{
+{I}if (signature.Algorithm != "ES256" || key.KeySize != 256)
+{I}{{
+{II}return false;
+{I}}}
+
+{I}return Verify(that, signature, key.VerifyHash);
+}}"""
+    )
+
+
+def _generate_json() -> Stripped:
+    """Generate the functions which de/serialize a signature to and from JSON."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Serialize <paramref name="signature" /> to a JSON object.
+/// </summary>
+/// <remarks>
+/// The value is encoded in Base-64, and the key ID is omitted if not set.
+/// </remarks>
+public static Nodes.JsonObject ToJsonObject(Signature signature)
+{{
+{I}var result = new Nodes.JsonObject();
+
+{I}result["algorithm"] = Nodes.JsonValue.Create(signature.Algorithm);
+{I}result["hashAlgorithm"] = Nodes.JsonValue.Create(signature.HashAlgorithm);
+
+{I}if (signature.KeyId != null)
+{I}{{
+{II}result["keyId"] = Nodes.JsonValue.Create(signature.KeyId);
+{I}}}
+
+{I}result["value"] = Nodes.JsonValue.Create(
+{II}System.Convert.ToBase64String(signature.Value));
+
+{I}return result;
+}}
+
+private delegate T? PropertyDeserializer<T>(
+{I}Nodes.JsonNode node,
+{I}out Reporting.Error? error)
+{I}where T : class;
+
+/// <summary>
+/// Deserialize a property of <paramref name="obj" /> with
+/// the deserialization function <paramref name="deserialize" />.
+/// </summary>
+/// <exception cref="Jsonization.Exception">
+/// Thrown when the property is missing or invalid.
+/// </exception>
+private static T PropertyFrom<T>(
+{I}Nodes.JsonObject obj,
+{I}string name,
+{I}PropertyDeserializer<T> deserialize)
+{I}where T : class
+{{
+{I}Nodes.JsonNode node = obj[name]
+{II}?? throw new Jsonization.Exception(
+{III}"",
+{III}$"Required property \\"{{name}}\\" is missing");
+
+{I}T? result = deserialize(node, out Reporting.Error? error);
+{I}if (error != null)
+{I}{{
+{II}throw new Jsonization.Exception(name, error.Cause);
+{I}}}
+
+{I}return result
+{II}?? throw new System.InvalidOperationException(
+{III}$"Unexpected {{name}} null when error is also null");
+}}
+
+/// <summary>
+/// Deserialize a signature from <paramref name="node" />.
+/// </summary>
+/// <param name="node">JSON node to be parsed</param>
+/// <exception cref="Jsonization.Exception">
+/// Thrown when <paramref name="node" /> is not a valid JSON
+/// representation of a signature.
+/// </exception>
+public static Signature SignatureFrom(Nodes.JsonNode node)
+{{
+{I}Nodes.JsonObject? obj = node as Nodes.JsonObject;
+{I}if (obj == null)
+{I}{{
+{II}throw new Jsonization.Exception(
+{III}"",
+{III}$"Expected a JsonObject, but got {{node.GetType()}}");
+{I}}}
+
+{I}string algorithm = PropertyFrom<string>(
+{II}obj, "algorithm", Jsonization.DeserializeImplementation.StringFrom);
+{I}string hashAlgorithm = PropertyFrom<string>(
+{II}obj, "hashAlgorithm", Jsonization.DeserializeImplementation.StringFrom);
+{I}string? keyId = obj["keyId"] != null
+{II}? PropertyFrom<string>(
+{III}obj, "keyId", Jsonization.DeserializeImplementation.StringFrom)
+{II}: null;
+{I}byte[] value = PropertyFrom<byte[]>(
+{II}obj, "value", Jsonization.DeserializeImplementation.BytesFrom);
+
+{I}return new Signature(algorithm, value, keyId, hashAlgorithm);
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(namespace: csharp_common.NamespaceIdentifier) -> str:
+    """
+    Generate the C# code for signing and verifying the model instances.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    signing_blocks = [
+        _generate_signature(),
+        _generate_compute_hash(),
+        _generate_sign(),
+        _generate_verify(),
+        _generate_json(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Sign and verify model instances to protect their integrity.
+{I}/// </summary>
+{I}public static class Signing
+{I}{{
+"""
+    )
+
+    for i, signing_block in enumerate(signing_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(signing_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Signing")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using Nodes = System.Text.Json.Nodes;
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
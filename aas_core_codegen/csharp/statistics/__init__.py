@@ -0,0 +1,4 @@
+"""Generate C# code for computing size statistics over model instances."""
+from aas_core_codegen.csharp.statistics import _generate
+
+generate = _generate.generate
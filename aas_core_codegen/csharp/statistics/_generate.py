@@ -0,0 +1,286 @@
+"""Generate C# code for computing size statistics over model instances."""
+
+import io
+import textwrap
+from typing import Optional, List
+
+from icontract import ensure
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Stripped, indent_but_first_line
+from aas_core_codegen.csharp import common as csharp_common, naming as csharp_naming
+from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II
+
+
+def _generate_stats() -> Stripped:
+    """Generate the container of the statistics."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Capture the size statistics of a model instance and its descendants.
+/// </summary>
+public class Stats
+{{
+{I}/// <summary>
+{I}/// Number of instances by the name of their class
+{I}/// </summary>
+{I}public readonly Dictionary<string, long> CountByClass = (
+{II}new Dictionary<string, long>());
+
+{I}/// <summary>
+{I}/// Total number of instances
+{I}/// </summary>
+{I}public long Count;
+
+{I}/// <summary>
+{I}/// Total number of characters over all the string properties
+{I}/// </summary>
+{I}public long StringPayload;
+
+{I}/// <summary>
+{I}/// Total number of bytes over all the byte-array properties
+{I}/// </summary>
+{I}public long BytePayload;
+
+{I}/// <summary>
+{I}/// Maximum depth of nesting, where the root instance is at depth 1
+{I}/// </summary>
+{I}public int MaxDepth;
+
+{I}internal void CountInstance(string className)
+{I}{{
+{II}Count++;
+{II}CountByClass.TryGetValue(className, out long count);
+{II}CountByClass[className] = count + 1;
+{I}}}
+}}  // public class Stats"""
+    )
+
+
+def _generate_visit_for_class(cls: intermediate.ConcreteClass) -> Stripped:
+    """Generate the visit method which accumulates the statistics of ``cls``."""
+    cls_name = csharp_naming.class_name(cls.name)
+
+    statements = [
+        Stripped(f"_stats.CountInstance({csharp_common.string_literal(cls_name)});")
+    ]  # type: List[Stripped]
+
+    for prop in cls.properties:
+        prop_name = csharp_naming.property_name(prop.name)
+        type_anno = intermediate.beneath_optional(prop.type_annotation)
+        is_optional = isinstance(
+            prop.type_annotation, intermediate.OptionalTypeAnnotation
+        )
+
+        if isinstance(type_anno, intermediate.ListTypeAnnotation):
+            primitive_type = intermediate.try_primitive_type(type_anno.items)
+        else:
+            primitive_type = intermediate.try_primitive_type(type_anno)
+
+        payload = None  # type: Optional[str]
+        if primitive_type is intermediate.PrimitiveType.STR:
+            payload = "StringPayload"
+        elif primitive_type is intermediate.PrimitiveType.BYTEARRAY:
+            payload = "BytePayload"
+        else:
+            pass
+
+        if payload is None:
+            continue
+
+        if isinstance(type_anno, intermediate.ListTypeAnnotation):
+            statement = Stripped(
+                f"""\
+foreach (var item in that.{prop_name})
+{{
+{I}_stats.{payload} += item.Length;
+}}"""
+            )
+
+            if is_optional:
+                statement = Stripped(
+                    f"""\
+if (that.{prop_name} != null)
+{{
+{I}{indent_but_first_line(statement, I)}
+}}"""
+                )
+
+            statements.append(statement)
+        else:
+            accessor = (
+                f"that.{prop_name}?.Length ?? 0"
+                if is_optional
+                else f"that.{prop_name}.Length"
+            )
+
+            statements.append(Stripped(f"_stats.{payload} += {accessor};"))
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+public override void Visit(Aas.{cls_name} that)
+{{
+"""
+    )
+
+    for i, statement in enumerate(statements):
+        if i > 0:
+            # Separate the loops with blank lines for readability.
+            if "\n" in statement or "\n" in statements[i - 1]:
+                writer.write("\n\n")
+            else:
+                writer.write("\n")
+
+        writer.write(textwrap.indent(statement, I))
+
+    writer.write(f"\n\n{I}base.Visit(that);\n}}")
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_collector(symbol_table: intermediate.SymbolTable) -> Stripped:
+    """Generate the visitor which collects the statistics."""
+    blocks = [
+        Stripped("private readonly Stats _stats;"),
+        Stripped("private int _depth;"),
+        Stripped(
+            f"""\
+public Collector(Stats stats)
+{{
+{I}_stats = stats;
+}}"""
+        ),
+        Stripped(
+            f"""\
+public override void Visit(Aas.IClass that)
+{{
+{I}_depth++;
+{I}if (_depth > _stats.MaxDepth)
+{I}{{
+{II}_stats.MaxDepth = _depth;
+{I}}}
+
+{I}base.Visit(that);
+
+{I}_depth--;
+}}"""
+        ),
+    ]  # type: List[Stripped]
+
+    for our_type in symbol_table.our_types:
+        if not isinstance(our_type, intermediate.ConcreteClass):
+            continue
+
+        blocks.append(_generate_visit_for_class(cls=our_type))
+
+    writer = io.StringIO()
+    writer.write(
+        """\
+/// <summary>
+/// Accumulate the statistics while descending through the instances.
+/// </summary>
+private class Collector : Visitation.VisitorThrough
+{
+"""
+    )
+
+    for i, block in enumerate(blocks):
+        if i > 0:
+            writer.write("\n\n")
+        writer.write(textwrap.indent(block, I))
+
+    writer.write("\n}  // private class Collector")
+
+    return Stripped(writer.getvalue())
+
+
+def _generate_compute() -> Stripped:
+    """Generate the entry point for computing the statistics."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Compute the size statistics over <paramref name="that" />
+/// and all its descendants.
+/// </summary>
+/// <remarks>
+/// This is useful for capacity planning and for diagnosing pathological
+/// inputs.
+/// </remarks>
+public static Stats Compute(Aas.IClass that)
+{{
+{I}var stats = new Stats();
+{I}var collector = new Collector(stats);
+{I}collector.Visit(that);
+{I}return stats;
+}}"""
+    )
+
+
+# fmt: off
+@ensure(
+    lambda result:
+    result.endswith('\n'),
+    "Trailing newline mandatory for valid end-of-files"
+)
+# fmt: on
+def generate(
+    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+) -> str:
+    """
+    Generate the C# code for computing the size statistics.
+
+    The ``namespace`` defines the AAS C# namespace.
+    """
+    statistics_blocks = [
+        _generate_stats(),
+        _generate_collector(symbol_table=symbol_table),
+        _generate_compute(),
+    ]  # type: List[Stripped]
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+namespace {namespace}
+{{
+{I}/// <summary>
+{I}/// Compute size statistics over the model instances.
+{I}/// </summary>
+{I}public static class Statistics
+{I}{{
+"""
+    )
+
+    for i, statistics_block in enumerate(statistics_blocks):
+        if i > 0:
+            writer.write("\n\n")
+
+        writer.write(textwrap.indent(statistics_block, II))
+
+    writer.write(f"\n{I}}}  // public static class Statistics")
+    writer.write(f"\n}}  // namespace {namespace}")
+
+    blocks = [
+        csharp_common.WARNING,
+        Stripped(
+            f"""\
+using System.Collections.Generic;  // can't alias
+
+using Aas = {namespace};"""
+        ),
+        Stripped(writer.getvalue()),
+        csharp_common.WARNING,
+    ]  # type: List[Stripped]
+
+    out = io.StringIO()
+    for i, block in enumerate(blocks):
+        if i > 0:
+            out.write("\n\n")
+
+        assert not block.startswith("\n")
+        assert not block.endswith("\n")
+        out.write(block)
+
+    out.write("\n")
+
+    return out.getvalue()
@@ -1,9 +1,10 @@
 """Generate C# code for de/serialization based on the intermediate representation."""
 
+import collections
 import io
 import textwrap
 import xml.sax.saxutils
-from typing import Tuple, Optional, List
+from typing import Tuple, Optional, List, MutableMapping, Set
 
 from icontract import ensure
 
@@ -93,75 +94,191 @@ public static string? {to_str_name}(Aas.{name}? that)
 
     # endregion
 
-    # region From-string-map
+    # region From-string-method
 
-    from_str_map_name = csharp_naming.private_property_name(
+    from_str_name = csharp_naming.method_name(
         Identifier(f"{enumeration.name}_from_string")
     )
 
-    from_str_map_writer = io.StringIO()
-    from_str_map_writer.write(
+    # We dispatch with a switch statement instead of a dictionary. The C# compiler
+    # translates the switch on strings into a jump table keyed on the computed hash
+    # of the text, which avoids the overhead of the dictionary look-up in
+    # the deserialization.
+    from_str_writer = io.StringIO()
+    from_str_writer.write(
         f"""\
-[CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-private static readonly Dictionary<string, Aas.{name}> {from_str_map_name} = (
-{I}new Dictionary<string, Aas.{name}>()
+/// <summary>
+/// Parse the string representation of <see cref={xml.sax.saxutils.quoteattr(name)} />.
+/// </summary>
+/// <remarks>
+/// If <paramref name="text" /> is not a valid string representation
+/// of a literal of <see cref={xml.sax.saxutils.quoteattr(name)} />,
+/// return <c>null</c>.
+/// </remarks>
+public static Aas.{name}? {from_str_name}(string text)
+{{
+{I}switch (text)
 {I}{{
 """
     )
 
-    for i, literal in enumerate(enumeration.literals):
+    for literal in enumeration.literals:
         literal_name = csharp_naming.enum_literal_name(literal.name)
-        from_str_map_writer.write(
-            f"{II}{{ {csharp_common.string_literal(literal.value)}, "
-            f"Aas.{name}.{literal_name} }}"
+        from_str_writer.write(
+            f"""\
+{II}case {csharp_common.string_literal(literal.value)}:
+{III}return Aas.{name}.{literal_name};
+"""
         )
 
-        if i < len(enumeration.literals) - 1:
-            from_str_map_writer.write(",")
+    from_str_writer.write(
+        f"""\
+{II}default:
+{III}return null;
+{I}}}
+}}"""
+    )
 
-        from_str_map_writer.write("\n")
+    blocks.append(Stripped(from_str_writer.getvalue()))
 
-    from_str_map_writer.write(f"{I}}});")
+    # endregion
 
-    blocks.append(Stripped(from_str_map_writer.getvalue()))
+    return Stripped("\n\n".join(blocks))
 
-    # endregion
 
-    # region From-string-method
+def _normalize_for_lenient_parsing(text: str) -> str:
+    """
+    Normalize the ``text`` as the generated ``NormalizeForLenientParsing``.
+
+    Keep this function in sync with :py:func:`_generate_normalize_for_lenient_parsing`.
+    We map only the ASCII characters since Python and C# disagree on the case
+    mapping and the white space outside of ASCII.
+    """
+    return "".join(
+        character.lower() if "A" <= character <= "Z" else character
+        for character in text
+        if character not in " \t\n\r\f\v_-"
+    )
+
+
+def _generate_normalize_for_lenient_parsing() -> Stripped:
+    """Generate the normalization of the text for the lenient parsing."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Normalize <paramref name="text" /> for the lenient parsing of enumerations.
+/// </summary>
+/// <remarks>
+/// We lower-case the characters, and remove the white space, the underscores
+/// and the hyphens. Only the ASCII characters are mapped so that the result
+/// matches the normalization of the literals at the generation time.
+/// </remarks>
+private static string NormalizeForLenientParsing(string text)
+{{
+{I}var builder = new System.Text.StringBuilder(text.Length);
+{I}foreach (char character in text)
+{I}{{
+{II}if (character == ' '
+{III}|| (character >= '\\t' && character <= '\\r')
+{III}|| character == '_'
+{III}|| character == '-')
+{II}{{
+{III}continue;
+{II}}}
+
+{II}builder.Append(
+{III}character >= 'A' && character <= 'Z'
+{III}{I}? (char)(character + ('a' - 'A'))
+{III}{I}: character);
+{I}}}
+
+{I}return builder.ToString();
+}}"""
+    )
+
+
+def _generate_enum_from_string_lenient(
+    enumeration: intermediate.Enumeration,
+) -> Stripped:
+    """Generate the method for parsing the enumeration from a string leniently."""
+    name = csharp_naming.enum_name(enumeration.name)
 
     from_str_name = csharp_naming.method_name(
         Identifier(f"{enumeration.name}_from_string")
     )
 
-    from_str_writer = io.StringIO()
-    from_str_writer.write(
+    from_str_lenient_name = csharp_naming.method_name(
+        Identifier(f"{enumeration.name}_from_string_lenient")
+    )
+
+    # We accept both the string representations of the literals and the names of
+    # the literals as aliases. If two literals share the same normalized alias,
+    # the alias is ambiguous and we leave it out.
+    literal_names_by_alias = (
+        collections.OrderedDict()
+    )  # type: MutableMapping[str, Set[str]]
+
+    for literal in enumeration.literals:
+        literal_name = csharp_naming.enum_literal_name(literal.name)
+        for alias in (literal.value, literal_name):
+            normalized = _normalize_for_lenient_parsing(alias)
+            if normalized not in literal_names_by_alias:
+                literal_names_by_alias[normalized] = set()
+
+            literal_names_by_alias[normalized].add(literal_name)
+
+    writer = io.StringIO()
+    writer.write(
         f"""\
 /// <summary>
-/// Parse the string representation of <see cref={xml.sax.saxutils.quoteattr(name)} />.
+/// Parse the string representation of <see cref={xml.sax.saxutils.quoteattr(name)} />
+/// leniently.
 /// </summary>
 /// <remarks>
-/// If <paramref name="text" /> is not a valid string representation
-/// of a literal of <see cref={xml.sax.saxutils.quoteattr(name)} />,
-/// return <c>null</c>.
+/// <para>
+/// If <paramref name="text" /> is not a valid string representation,
+/// we compare it case-insensitively against the string representations and
+/// the names of the literals, ignoring the white space, the underscores and
+/// the hyphens.
+/// </para>
+/// <para>
+/// If there is still no match, return <c>null</c>.
+/// </para>
 /// </remarks>
-public static Aas.{name}? {from_str_name}(string text)
+public static Aas.{name}? {from_str_lenient_name}(string text)
 {{
-{I}if ({from_str_map_name}.TryGetValue(text, out {name} value))
+{I}Aas.{name}? strict = {from_str_name}(text);
+{I}if (strict.HasValue)
 {I}{{
-{II}return value;
+{II}return strict;
 {I}}}
-{I}else
+
+{I}switch (NormalizeForLenientParsing(text))
 {I}{{
-{II}return null;
-{I}}}
-}}"""
+"""
     )
 
-    blocks.append(Stripped(from_str_writer.getvalue()))
+    for alias, literal_names in literal_names_by_alias.items():
+        if len(literal_names) != 1:
+            continue
 
-    # endregion
+        literal_name = next(iter(literal_names))
+        writer.write(
+            f"""\
+{II}case {csharp_common.string_literal(alias)}:
+{III}return Aas.{name}.{literal_name};
+"""
+        )
 
-    return Stripped("\n\n".join(blocks))
+    writer.write(
+        f"""\
+{II}default:
+{III}return null;
+{I}}}
+}}"""
+    )
+
+    return Stripped(writer.getvalue())
 
 
 # fmt: off
@@ -173,18 +290,22 @@ public static Aas.{name}? {from_str_name}(string text)
 )
 # fmt: on
 def generate(
-    symbol_table: intermediate.SymbolTable, namespace: csharp_common.NamespaceIdentifier
+    symbol_table: intermediate.SymbolTable,
+    namespace: csharp_common.NamespaceIdentifier,
+    lenient: bool = False,
 ) -> Tuple[Optional[str], Optional[List[Error]]]:
     """
     Generate the C# code for the general serialization.
 
     The ``namespace`` defines the AAS C# namespace.
+
+    If ``lenient`` is set, we additionally generate ``*FromStringLenient`` functions
+    which accept the case-insensitive input and the names of the literals.
     """
     blocks = [
         csharp_common.WARNING,
         Stripped(
             f"""\
-using CodeAnalysis = System.Diagnostics.CodeAnalysis;
 using System.Collections.Generic;  // can't alias
 
 using Aas = {namespace};"""
@@ -201,6 +322,14 @@ using Aas = {namespace};"""
             _generate_enum_to_and_from_string(enumeration=our_type)
         )
 
+        if lenient:
+            stringification_blocks.append(
+                _generate_enum_from_string_lenient(enumeration=our_type)
+            )
+
+    if lenient:
+        stringification_blocks.append(_generate_normalize_for_lenient_parsing())
+
     writer = io.StringIO()
     writer.write(
         f"""\
@@ -1,5 +1,7 @@
 """Generate the C# data structures from the intermediate representation."""
+import collections
 import io
+import re
 import textwrap
 from typing import (
     Optional,
@@ -9,6 +11,7 @@ from typing import (
     cast,
     Union,
     Mapping,
+    MutableMapping,
     Final,
 )
 
@@ -361,6 +364,14 @@ def _generate_interface(
                     Stripped(f"public {prop_type} {prop_name} {{ get; set; }}")
                 )
 
+            if (
+                prop.description is not None
+                and prop.description.renamed_from is not None
+            ):
+                blocks.append(
+                    _generate_renamed_property_shim(prop=prop, in_interface=True)
+                )
+
     # endregion
 
     # region Signatures
@@ -452,6 +463,111 @@ public IEnumerable<{items_type}> Over{prop_name}OrEmpty();"""
     return Stripped(writer.getvalue()), None
 
 
+@require(
+    lambda prop:
+    prop.description is not None and prop.description.renamed_from is not None
+)
+def _generate_renamed_property_shim(
+    prop: intermediate.Property, in_interface: bool
+) -> Stripped:
+    """
+    Generate the deprecated property forwarding the previous name to ``prop``.
+
+    If ``in_interface`` is set, only the declaration is generated.
+    """
+    assert prop.description is not None
+    assert prop.description.renamed_from is not None
+
+    prop_type = csharp_common.generate_type(type_annotation=prop.type_annotation)
+    prop_name = csharp_naming.property_name(prop.name)
+    old_name = csharp_naming.property_name(prop.description.renamed_from)
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+/// <summary>
+/// Forward to <see cref="{prop_name}" /> under its previous name.
+/// </summary>
+[System.Obsolete("Renamed to {prop_name} in the meta-model")]
+"""
+    )
+
+    if in_interface:
+        writer.write(f"public {prop_type} {old_name} {{ get; set; }}")
+    else:
+        writer.write(
+            f"""\
+public {prop_type} {old_name}
+{{
+{I}get => {prop_name};
+{I}set => {prop_name} = value;
+}}"""
+        )
+
+    return Stripped(writer.getvalue())
+
+
+@require(
+    lambda cls:
+    cls.description is not None and cls.description.renamed_from is not None
+)
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def _generate_renamed_class_shim(
+    cls: intermediate.ConcreteClass,
+) -> Tuple[Optional[Stripped], Optional[Error]]:
+    """Generate the deprecated class keeping the previous name of ``cls``."""
+    assert cls.description is not None
+    assert cls.description.renamed_from is not None
+
+    if cls.constructor.is_implementation_specific:
+        return None, Error(
+            cls.parsed.node,
+            f"We can not forward the implementation-specific constructor "
+            f"of the class {cls.name!r} to its previous name "
+            f"{cls.description.renamed_from!r}",
+        )
+
+    name = csharp_naming.class_name(cls.name)
+    old_name = csharp_naming.class_name(cls.description.renamed_from)
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+/// <summary>
+/// Keep the previous name of <see cref="{name}" /> so that the downstream code
+/// compiles across the rename in the meta-model.
+/// </summary>
+[System.Obsolete("Renamed to {name} in the meta-model")]
+public class {old_name} : {name}
+{{
+"""
+    )
+
+    arg_codes = _generate_constructor_arguments(cls=cls)
+    if len(arg_codes) == 0:
+        writer.write(f"{I}// Intentionally empty.\n")
+    else:
+        arg_block = textwrap.indent(",\n".join(arg_codes), II)
+        base_args = ", ".join(
+            csharp_naming.argument_name(arg.name) for arg in cls.constructor.arguments
+        )
+
+        writer.write(
+            f"""\
+{I}public {old_name}(
+{arg_block})
+{II}: base({base_args})
+{I}{{
+{II}// Intentionally empty.
+{I}}}
+"""
+        )
+
+    writer.write("}")
+
+    return Stripped(writer.getvalue()), None
+
+
 class _DescendBodyUnroller(csharp_unrolling.Unroller):
     """Generate the code that unrolls descent into an element."""
 
@@ -707,6 +823,25 @@ def _generate_default_value(default: intermediate.Default) -> Stripped:
     return Stripped(code)
 
 
+def _generate_constructor_arguments(cls: intermediate.ConcreteClass) -> List[Stripped]:
+    """Generate the arguments of the constructor of ``cls`` with their defaults."""
+    arg_codes = []  # type: List[Stripped]
+    for arg in cls.constructor.arguments:
+        arg_type = csharp_common.generate_type(type_annotation=arg.type_annotation)
+        arg_name = csharp_naming.argument_name(arg.name)
+
+        if arg.default is None:
+            arg_codes.append(Stripped(f"{arg_type} {arg_name}"))
+        else:
+            arg_codes.append(
+                Stripped(
+                    f"{arg_type} {arg_name} = {_generate_default_value(arg.default)}"
+                )
+            )
+
+    return arg_codes
+
+
 @require(lambda cls: not cls.is_implementation_specific)
 @require(lambda cls: not cls.constructor.is_implementation_specific)
 @ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
@@ -727,19 +862,7 @@ def _generate_constructor(
 
     blocks = []  # type: List[str]
 
-    arg_codes = []  # type: List[str]
-    for arg in cls.constructor.arguments:
-        arg_type = csharp_common.generate_type(type_annotation=arg.type_annotation)
-        arg_name = csharp_naming.argument_name(arg.name)
-
-        if arg.default is None:
-            arg_codes.append(Stripped(f"{arg_type} {arg_name}"))
-        else:
-            arg_codes.append(
-                Stripped(
-                    f"{arg_type} {arg_name} = {_generate_default_value(arg.default)}"
-                )
-            )
+    arg_codes = _generate_constructor_arguments(cls=cls)
 
     if len(arg_codes) == 1:
         blocks.append(f"public {cls_name}({arg_codes[0]})\n{{")
@@ -807,10 +930,78 @@ def _generate_constructor(
     return Stripped("\n".join(blocks)), None
 
 
+# The placeholders allow the snippets to refer to the names derived from
+# the meta-model so that the snippets survive the renames in the meta-model.
+_PLACEHOLDER_RE = re.compile(r"\b_[A-Z][A-Z0-9_]*_\b")
+
+
+def _placeholders_for(
+    cls: intermediate.ClassUnion, namespace: csharp_common.NamespaceIdentifier
+) -> Mapping[str, str]:
+    """Map the placeholders available in the snippets of ``cls`` to their values."""
+    result = collections.OrderedDict(
+        [
+            ("_NAMESPACE_", str(namespace)),
+            ("_CLASS_NAME_", csharp_naming.class_name(cls.name)),
+            ("_INTERFACE_NAME_", csharp_naming.interface_name(cls.name)),
+        ]
+    )  # type: MutableMapping[str, str]
+
+    for prop in cls.properties:
+        result[f"_PROPERTY_{prop.name.upper()}_"] = csharp_naming.property_name(
+            prop.name
+        )
+
+    return result
+
+
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def _substitute_placeholders(
+    implementation: Stripped,
+    implementation_key: specific_implementations.ImplementationKey,
+    cls: intermediate.ClassUnion,
+    namespace: csharp_common.NamespaceIdentifier,
+) -> Tuple[Optional[Stripped], Optional[Error]]:
+    """
+    Replace the placeholders in the snippet ``implementation`` of ``cls``.
+
+    The available placeholders are ``_NAMESPACE_``, ``_CLASS_NAME_``,
+    ``_INTERFACE_NAME_`` and ``_PROPERTY_{upper-case property name}_``, *e.g.*,
+    ``_PROPERTY_ID_SHORT_``.
+    """
+    placeholders = _placeholders_for(cls=cls, namespace=namespace)
+
+    unknown = sorted(
+        {
+            mtch.group(0)
+            for mtch in _PLACEHOLDER_RE.finditer(implementation)
+            if mtch.group(0) not in placeholders
+        }
+    )
+
+    if len(unknown) > 0:
+        return None, Error(
+            cls.parsed.node,
+            f"The implementation snippet {implementation_key} uses "
+            f"unknown placeholder(s): {', '.join(unknown)}; "
+            f"the known placeholders are: {', '.join(placeholders)}",
+        )
+
+    return (
+        Stripped(
+            _PLACEHOLDER_RE.sub(
+                lambda mtch: placeholders[mtch.group(0)], implementation
+            )
+        ),
+        None,
+    )
+
+
 @require(lambda cls: not cls.is_implementation_specific)
 @ensure(lambda result: (result[0] is None) ^ (result[1] is None))
 def _generate_class(
     cls: intermediate.ConcreteClass,
+    namespace: csharp_common.NamespaceIdentifier,
     spec_impls: specific_implementations.SpecificImplementations,
 ) -> Tuple[Optional[Stripped], Optional[Error]]:
     """Generate C# code for the given concrete class ``cls``."""
@@ -915,6 +1106,11 @@ def _generate_class(
 
         blocks.append(Stripped("\n".join(prop_blocks)))
 
+        if prop.description is not None and prop.description.renamed_from is not None:
+            blocks.append(
+                _generate_renamed_property_shim(prop=prop, in_interface=False)
+            )
+
     # endregion
 
     # region OverXOrEmpty getter
@@ -971,6 +1167,17 @@ public IEnumerable<{items_type}> Over{prop_name}OrEmpty()
                 )
                 continue
 
+            implementation, error = _substitute_placeholders(
+                implementation=implementation,
+                implementation_key=implementation_key,
+                cls=cls,
+                namespace=namespace,
+            )
+            if error is not None:
+                errors.append(error)
+                continue
+
+            assert implementation is not None
             blocks.append(implementation)
         else:
             # NOTE (mristin, 2021-09-16):
@@ -1070,7 +1277,17 @@ public T Transform<TContext, T>(
                 )
             )
         else:
-            blocks.append(implementation)
+            implementation, error = _substitute_placeholders(
+                implementation=implementation,
+                implementation_key=implementation_key,
+                cls=cls,
+                namespace=namespace,
+            )
+            if error is not None:
+                errors.append(error)
+            else:
+                assert implementation is not None
+                blocks.append(implementation)
     else:
         constructor_block, error = _generate_constructor(cls=cls)
 
@@ -1205,13 +1422,20 @@ public interface IClass
                 f"Types/{something.name}.cs"
             )
 
-            code = spec_impls.get(implementation_key, None)
-            if code is None:
+            implementation = spec_impls.get(implementation_key, None)
+            if implementation is None:
                 error = Error(
                     something.parsed.node,
                     f"The implementation is missing "
                     f"for the implementation-specific class: {implementation_key}",
                 )
+            else:
+                code, error = _substitute_placeholders(
+                    implementation=implementation,
+                    implementation_key=implementation_key,
+                    cls=something,
+                    namespace=namespace,
+                )
         else:
             if isinstance(something, intermediate.Enumeration):
                 # BEFORE-RELEASE (mristin, 2021-12-13): test in isolation
@@ -1222,7 +1446,21 @@ public interface IClass
 
             elif isinstance(something, intermediate.ConcreteClass):
                 # BEFORE-RELEASE (mristin, 2021-12-13): test in isolation
-                code, error = _generate_class(cls=something, spec_impls=spec_impls)
+                code, error = _generate_class(
+                    cls=something, namespace=namespace, spec_impls=spec_impls
+                )
+
+                if (
+                    code is not None
+                    and something.description is not None
+                    and something.description.renamed_from is not None
+                ):
+                    shim, error = _generate_renamed_class_shim(cls=something)
+                    if error is not None:
+                        code = None
+                    else:
+                        assert shim is not None
+                        code = Stripped(f"{code}\n\n{shim}")
             else:
                 assert_never(something)
 
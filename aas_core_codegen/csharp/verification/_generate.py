@@ -1,5 +1,7 @@
 """Generate the invariant verifiers from the intermediate representation."""
+import collections
 import io
+import re
 import textwrap
 from typing import (
     Tuple,
@@ -8,6 +10,7 @@ from typing import (
     Sequence,
     Set,
     Mapping,
+    MutableMapping,
     Union,
 )
 
@@ -33,12 +36,167 @@ from aas_core_codegen.csharp.common import (
     INDENT3 as III,
     INDENT4 as IIII,
 )
-from aas_core_codegen.intermediate import type_inference as intermediate_type_inference
+from aas_core_codegen.intermediate import (
+    type_inference as intermediate_type_inference,
+    pseudo_code as intermediate_pseudo_code,
+)
 from aas_core_codegen.parse import tree as parse_tree, retree as parse_retree
 
 
 # region Verify
 
+_PUBLIC_STATIC_METHOD_RE = re.compile(
+    r"\bpublic\s+static\s+[a-zA-Z_0-9.<>?\[\], ]+?\s+([a-zA-Z_][a-zA-Z_0-9]*)\s*\("
+)
+
+
+# NOTE: The snippets of the classes can use placeholders such as ``_CLASS_NAME_``,
+# but no placeholders are substituted in the verification snippets.
+_PLACEHOLDER_RE = re.compile(r"\b_[A-Z][A-Z0-9_]*_\b")
+
+
+class _Parameter:
+    """Represent a parameter of a method parsed from a snippet."""
+
+    def __init__(self, type_code: str, name: str) -> None:
+        """Initialize with the given values."""
+        self.type_code = type_code
+        self.name = name
+
+
+def _parse_public_static_methods(code: str) -> Mapping[str, List[_Parameter]]:
+    """
+    Parse the public static methods of ``code`` just enough to get their signatures.
+
+    We do not parse C# properly, but only look for the declarations and split
+    the parameters on the top-level commas.
+
+    :return: map of method names to their parameters
+    """
+    result = collections.OrderedDict()  # type: MutableMapping[str, List[_Parameter]]
+
+    for mtch in _PUBLIC_STATIC_METHOD_RE.finditer(code):
+        # We split the parameters manually since the types might be generic
+        # and contain commas themselves, *e.g.*, ``Dictionary<string, int>``.
+        parameters = []  # type: List[str]
+        depth = 0
+        start = mtch.end()
+        cursor = start
+        while cursor < len(code):
+            character = code[cursor]
+            if character in "(<[":
+                depth += 1
+            elif character in ")>]":
+                if depth == 0:
+                    break
+                depth -= 1
+            elif character == "," and depth == 0:
+                parameters.append(code[start:cursor])
+                start = cursor + 1
+            else:
+                pass
+
+            cursor += 1
+
+        parameters.append(code[start:cursor])
+
+        parsed_parameters = []  # type: List[_Parameter]
+        for parameter in parameters:
+            parameter = parameter.split("=")[0].strip()
+            if parameter == "":
+                continue
+
+            type_code, _, name = parameter.rpartition(" ")
+            parsed_parameters.append(
+                _Parameter(type_code="".join(type_code.split()), name=name)
+            )
+
+        result[mtch.group(1)] = parsed_parameters
+
+    return result
+
+
+def _accepts(parameter_type: str, expected_type: str) -> bool:
+    """
+    Check that a parameter of ``parameter_type`` accepts ``expected_type``.
+
+    The transpiled invariants pass the arguments with the types as defined in
+    the meta-model. The snippets usually qualify our types with the ``Aas``
+    alias, and can accept the lists as enumerables.
+    """
+    parameter_type = "".join(parameter_type.split()).replace("Aas.", "")
+    expected_type = "".join(expected_type.split())
+
+    if parameter_type == expected_type:
+        return True
+
+    return expected_type.startswith("List<") and parameter_type == (
+        "IEnumerable<" + expected_type[len("List<") :]
+    )
+
+
+def _verify_signature(
+    func: Union[
+        intermediate.ImplementationSpecificVerification,
+        intermediate.PatternVerification,
+    ],
+    key: specific_implementations.ImplementationKey,
+    implementation: Stripped,
+) -> Optional[str]:
+    """Check that the snippet ``implementation`` matches the signature of ``func``."""
+    placeholders = sorted(
+        {mtch.group(0) for mtch in _PLACEHOLDER_RE.finditer(implementation)}
+    )
+    if len(placeholders) > 0:
+        return (
+            f"The implementation snippet {key} uses unknown placeholder(s): "
+            f"{', '.join(placeholders)}; no placeholders are substituted "
+            f"in the verification snippets"
+        )
+
+    method_name = csharp_naming.method_name(func.name)
+    methods = _parse_public_static_methods(implementation)
+
+    parameters = methods.get(method_name, None)
+    if parameters is None:
+        defined = ", ".join(methods.keys()) if len(methods) > 0 else "none"
+        return (
+            f"The implementation snippet {key} is expected to define "
+            f"a public static method {method_name}, but we found only: {defined}"
+        )
+
+    parameter_names = [parameter.name for parameter in parameters]
+    expected_names = [csharp_naming.argument_name(arg.name) for arg in func.arguments]
+
+    if len(parameter_names) != len(expected_names):
+        return (
+            f"The method {method_name} in the implementation snippet {key} "
+            f"is expected to have {len(expected_names)} parameter(s) "
+            f"({', '.join(expected_names)}), but it has {len(parameter_names)} "
+            f"({', '.join(parameter_names)})"
+        )
+
+    for parameter_name, expected_name in zip(parameter_names, expected_names):
+        if parameter_name != expected_name:
+            return (
+                f"The method {method_name} in the implementation snippet {key} "
+                f"is expected to have the parameters ({', '.join(expected_names)}), "
+                f"but it has ({', '.join(parameter_names)})"
+            )
+
+    for parameter, arg in zip(parameters, func.arguments):
+        expected_type = csharp_common.generate_type(arg.type_annotation)
+        if not _accepts(
+            parameter_type=parameter.type_code, expected_type=expected_type
+        ):
+            return (
+                f"The parameter {parameter.name} of the method {method_name} "
+                f"in the implementation snippet {key} is expected to be "
+                f"of type {expected_type}, but it is {parameter.type_code}"
+            )
+
+    return None
+
 
 def verify(
     spec_impls: specific_implementations.SpecificImplementations,
@@ -47,19 +205,41 @@ def verify(
     """Verify all the implementation snippets related to verification."""
     errors = []  # type: List[str]
 
-    expected_keys = []  # type: List[specific_implementations.ImplementationKey]
-
     for func in verification_functions:
         if isinstance(func, intermediate.ImplementationSpecificVerification):
-            expected_keys.append(
-                specific_implementations.ImplementationKey(
-                    f"Verification/{func.name}.cs"
-                ),
+            key = specific_implementations.ImplementationKey(
+                f"Verification/{func.name}.cs"
             )
 
-    for key in expected_keys:
-        if key not in spec_impls:
-            errors.append(f"The implementation snippet is missing for: {key}")
+            implementation = spec_impls.get(key, None)
+            if implementation is None:
+                shared_key = intermediate_pseudo_code.implementation_key(func)
+                if shared_key not in spec_impls:
+                    errors.append(
+                        f"The implementation snippet is missing for: {key} "
+                        f"(and there is no shared snippet {shared_key} either)"
+                    )
+
+                continue
+
+            error = _verify_signature(func=func, key=key, implementation=implementation)
+            if error is not None:
+                errors.append(error)
+
+        elif isinstance(func, intermediate.PatternVerification):
+            # The snippets for pattern verification functions are optional, and
+            # replace the regular expressions with a faster implementation.
+            key = specific_implementations.ImplementationKey(
+                f"Verification/{func.name}.cs"
+            )
+
+            implementation = spec_impls.get(key, None)
+            if implementation is None:
+                continue
+
+            error = _verify_signature(func=func, key=key, implementation=implementation)
+            if error is not None:
+                errors.append(error)
 
     if len(errors) == 0:
         return None
@@ -1264,7 +1444,9 @@ def _generate_verify_method(our_type: intermediate.OurType) -> Stripped:
         return Stripped(f"Verification.Verify{name}")
 
     elif isinstance(our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)):
-        return Stripped("Verification.Verify")
+        # NOTE: We recurse over the transformer so that the cache, if any,
+        # is re-used for the descendants.
+        return Stripped("VerifyChild")
     else:
         assert_never(our_type)
 
@@ -1622,6 +1804,58 @@ def _generate_transformer(
     if len(errors) > 0:
         return None, errors
 
+    blocks.insert(
+        0,
+        Stripped(
+            f"""\
+private readonly Cache? _cache;
+
+internal Transformer(Cache? cache = null)
+{{
+{I}_cache = cache;
+}}
+
+/// <summary>
+/// Verify <paramref name="that" />, and re-use the results from the cache,
+/// if any.
+/// </summary>
+internal IEnumerable<Reporting.Error> VerifyChild(Aas.IClass that)
+{{
+{I}if (_cache == null)
+{I}{{
+{II}return Transform(that);
+{I}}}
+
+{I}if (!_cache.Errors.TryGetValue(that, out List<Reporting.Error>? errors))
+{I}{{
+{II}errors = new List<Reporting.Error>(Transform(that));
+{II}_cache.Errors.AddOrUpdate(that, errors);
+{I}}}
+
+{I}return CopyErrors(errors);
+}}
+
+/// <summary>
+/// Copy the cached <paramref name="errors" /> since the callers prepend
+/// their path segments to them.
+/// </summary>
+private static IEnumerable<Reporting.Error> CopyErrors(
+{I}List<Reporting.Error> errors)
+{{
+{I}foreach (var error in errors)
+{I}{{
+{II}var copy = new Reporting.Error(error.Cause);
+{II}foreach (var segment in error.PathSegments.Reverse())
+{II}{{
+{III}copy.PrependSegment(segment);
+{II}}}
+
+{II}yield return copy;
+{I}}}
+}}"""
+        ),
+    )
+
     writer = io.StringIO()
     writer.write(
         f"""\
@@ -1791,7 +2025,15 @@ using Aas = {namespace};"""
             )
 
             implementation = spec_impls.get(implementation_key, None)
-            if implementation is None:
+            if implementation is not None:
+                verification_blocks.append(implementation)
+                continue
+
+            # We fall back to the shared snippet only if there is no C# snippet
+            # so that the hand-written code always takes precedence.
+            shared_key = intermediate_pseudo_code.implementation_key(verification)
+            shared_code = spec_impls.get(shared_key, None)
+            if shared_code is None:
                 errors.append(
                     Error(
                         None,
@@ -1799,10 +2041,39 @@ using Aas = {namespace};"""
                         f"{verification.name!r} is missing: {implementation_key}",
                     )
                 )
-            else:
-                verification_blocks.append(implementation)
+                continue
+
+            understood, error = intermediate_pseudo_code.understand(
+                verification=verification, code=shared_code
+            )
+            if error is not None:
+                errors.append(error)
+                continue
+
+            assert understood is not None
+
+            implementation, error = _transpile_transpilable_verification(
+                verification=understood.verification,
+                symbol_table=symbol_table,
+                environment=base_environment,
+            )
+            if error is not None:
+                errors.append(understood.localize(error))
+                continue
+
+            assert implementation is not None
+            verification_blocks.append(implementation)
 
         elif isinstance(verification, intermediate.PatternVerification):
+            implementation_key = specific_implementations.ImplementationKey(
+                f"Verification/{verification.name}.cs"
+            )
+
+            implementation = spec_impls.get(implementation_key, None)
+            if implementation is not None:
+                verification_blocks.append(implementation)
+                continue
+
             implementation, error = _transpile_pattern_verification(
                 verification=verification
             )
@@ -1855,6 +2126,42 @@ private static readonly Verification.Transformer _transformer = (
         Stripped(
             f"""\
 /// <summary>
+/// Memoize the verification results of the instances which did not change.
+/// </summary>
+/// <remarks>
+/// <para>
+/// The instances are keyed by their identity and held weakly so that
+/// the cache does not keep them alive.
+/// </para>
+/// <para>
+/// The cache does <em>not</em> observe the modifications of the instances.
+/// The results of a modified instance and all its ancestors are stale until
+/// you <see cref="Invalidate" /> each one of them.
+/// </para>
+/// <para>
+/// The cache can be shared between the threads.
+/// </para>
+/// </remarks>
+public class Cache
+{{
+{I}internal readonly System.Runtime.CompilerServices.ConditionalWeakTable<
+{II}Aas.IClass, List<Reporting.Error>> Errors = new();
+
+{I}/// <summary>
+{I}/// Forget the verification results of <paramref name="that" />.
+{I}/// </summary>
+{I}public void Invalidate(Aas.IClass that)
+{I}{{
+{II}Errors.Remove(that);
+{I}}}
+}}  // public class Cache"""
+        )
+    )
+
+    verification_blocks.append(
+        Stripped(
+            f"""\
+/// <summary>
 /// Verify the constraints of <paramref name="that" /> recursively.
 /// </summary>
 /// <param name="that">
@@ -1866,6 +2173,64 @@ public static IEnumerable<Reporting.Error> Verify(Aas.IClass that)
 {I}{{
 {II}yield return error;
 {I}}}
+}}
+
+/// <summary>
+/// Verify the constraints of <paramref name="that" /> recursively, and
+/// re-use the results of the unchanged descendants from <paramref name="cache" />.
+/// </summary>
+/// <remarks>
+/// The results of the modified instances are stale unless you invalidate them,
+/// see <see cref="Cache" />.
+/// </remarks>
+public static List<Reporting.Error> Verify(Aas.IClass that, Cache cache)
+{{
+{I}return new List<Reporting.Error>(
+{II}new Verification.Transformer(cache).VerifyChild(that));
+}}"""
+        )
+    )
+
+    verification_blocks.append(
+        Stripped(
+            f"""\
+/// <summary>
+/// Verify the constraints of <paramref name="instances" /> recursively
+/// and concurrently.
+/// </summary>
+/// <remarks>
+/// <para>
+/// Use this function to verify large collections on multi-core machines,
+/// e.g., all the instances de-serialized from a large file.
+/// </para>
+/// <para>
+/// The errors are merged deterministically in the order of
+/// <paramref name="instances" />, and the index of the instance is prepended
+/// to the path of each error.
+/// </para>
+/// </remarks>
+public static List<Reporting.Error> VerifyInParallel(
+{I}IReadOnlyList<Aas.IClass> instances)
+{{
+{I}var errorsByIndex = new List<Reporting.Error>[instances.Count];
+
+{I}System.Threading.Tasks.Parallel.For(
+{II}0,
+{II}instances.Count,
+{II}i => errorsByIndex[i] = new List<Reporting.Error>(
+{III}Verify(instances[i])));
+
+{I}var result = new List<Reporting.Error>();
+{I}for (int i = 0; i < errorsByIndex.Length; i++)
+{I}{{
+{II}foreach (var error in errorsByIndex[i])
+{II}{{
+{III}error.PrependSegment(new Reporting.IndexSegment(i));
+{III}result.Add(error);
+{II}}}
+{I}}}
+
+{I}return result;
 }}"""
         )
     )
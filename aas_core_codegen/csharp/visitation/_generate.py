@@ -9,6 +9,7 @@ from icontract import ensure
 import aas_core_codegen.csharp.common as csharp_common
 from aas_core_codegen.csharp.common import INDENT as I, INDENT2 as II
 import aas_core_codegen.csharp.naming as csharp_naming
+import aas_core_codegen.csharp.unrolling as csharp_unrolling
 from aas_core_codegen import intermediate
 from aas_core_codegen.common import Error, Stripped, Rstripped, assert_never
 
@@ -62,6 +63,148 @@ public interface IVisitor
     return Stripped(writer.getvalue())
 
 
+class _VisitThroughUnroller(csharp_unrolling.Unroller):
+    """Generate the code that visits the instances referenced from an instance."""
+
+    def _unroll_primitive_type_annotation(
+        self,
+        unrollee_expr: str,
+        type_annotation: intermediate.PrimitiveTypeAnnotation,
+        path: List[str],
+        item_level: int,
+        key_value_level: int,
+    ) -> List[csharp_unrolling.Node]:
+        """Generate code for the given specific ``type_annotation``."""
+        # We can not visit a primitive type.
+        return []
+
+    def _unroll_our_type_annotation(
+        self,
+        unrollee_expr: str,
+        type_annotation: intermediate.OurTypeAnnotation,
+        path: List[str],
+        item_level: int,
+        key_value_level: int,
+    ) -> List[csharp_unrolling.Node]:
+        """Generate code for the given specific ``type_annotation``."""
+        our_type = type_annotation.our_type
+
+        if isinstance(our_type, intermediate.Enumeration):
+            return []
+
+        elif isinstance(our_type, intermediate.ConstrainedPrimitive):
+            # We can not visit a primitive type.
+            return []
+
+        assert isinstance(our_type, intermediate.Class)  # Exhaustively match
+
+        # We up-cast so that the visit goes through ``Visit(IClass)`` exactly as
+        # if we iterated over ``DescendOnce``.
+        return [csharp_unrolling.Node(f"Visit((IClass){unrollee_expr});", children=[])]
+
+    def _unroll_list_type_annotation(
+        self,
+        unrollee_expr: str,
+        type_annotation: intermediate.ListTypeAnnotation,
+        path: List[str],
+        item_level: int,
+        key_value_level: int,
+    ) -> List[csharp_unrolling.Node]:
+        """Generate code for the given specific ``type_annotation``."""
+        item_var = csharp_unrolling.Unroller._loop_var_name(
+            level=item_level, suffix="Item"
+        )
+
+        children = self.unroll(
+            unrollee_expr=item_var,
+            type_annotation=type_annotation.items,
+            path=[],  # Path is unused in this context
+            item_level=item_level + 1,
+            key_value_level=key_value_level,
+        )
+
+        if len(children) == 0:
+            return []
+
+        node = csharp_unrolling.Node(
+            text=f"foreach (var {item_var} in {unrollee_expr})",
+            children=children,
+        )
+
+        return [node]
+
+    def _unroll_optional_type_annotation(
+        self,
+        unrollee_expr: str,
+        type_annotation: intermediate.OptionalTypeAnnotation,
+        path: List[str],
+        item_level: int,
+        key_value_level: int,
+    ) -> List[csharp_unrolling.Node]:
+        """Generate code for the given specific ``type_annotation``."""
+        children = self.unroll(
+            unrollee_expr=unrollee_expr,
+            type_annotation=type_annotation.value,
+            path=path,
+            item_level=item_level,
+            key_value_level=key_value_level,
+        )
+
+        if len(children) == 0:
+            return []
+
+        return [
+            csharp_unrolling.Node(
+                text=f"if ({unrollee_expr} != null)", children=children
+            )
+        ]
+
+
+def _generate_visit_through(cls: intermediate.ConcreteClass) -> Stripped:
+    """
+    Generate the visit method of ``cls`` which simply descends into the instances.
+
+    We unroll the descent instead of iterating over ``DescendOnce`` so that
+    the visitation does not allocate an enumerator for each instance.
+    """
+    blocks = [
+        Stripped("// Just descend through, do nothing with <c>that</c>")
+    ]  # type: List[Stripped]
+
+    unroller = _VisitThroughUnroller()
+
+    for prop in cls.properties:
+        roots = unroller.unroll(
+            unrollee_expr=f"that.{csharp_naming.property_name(prop.name)}",
+            type_annotation=prop.type_annotation,
+            path=[],  # We do not use path in this context
+            item_level=0,
+            key_value_level=0,
+        )
+
+        blocks.extend(Stripped(csharp_unrolling.render(root)) for root in roots)
+
+    cls_name = csharp_naming.class_name(cls.name)
+
+    writer = io.StringIO()
+    writer.write(
+        f"""\
+public virtual void Visit({cls_name} that)
+{{
+"""
+    )
+
+    # The comment sticks to the first statement.
+    writer.write(textwrap.indent("\n".join(blocks[:2]), I))
+    for block in blocks[2:]:
+        writer.write("\n\n")
+        writer.write(textwrap.indent(block, I))
+
+    writer.write("\n}")
+
+    return Stripped(writer.getvalue())
+
+
 def _generate_visitor_through(symbol_table: intermediate.SymbolTable) -> Stripped:
     """Generate the visitor that simply iterates over the instances."""
     blocks = [
@@ -89,20 +232,7 @@ public virtual void Visit(IClass that)
             continue
 
         elif isinstance(our_type, intermediate.ConcreteClass):
-            cls_name = csharp_naming.class_name(our_type.name)
-            blocks.append(
-                Stripped(
-                    f"""\
-public virtual void Visit({cls_name} that)
-{{
-{I}// Just descend through, do nothing with <c>that</c>
-{I}foreach (var something in that.DescendOnce())
-{I}{{
-{II}Visit(something);
-{I}}}
-}}"""
-                )
-            )
+            blocks.append(_generate_visit_through(cls=our_type))
 
         else:
             assert_never(our_type)
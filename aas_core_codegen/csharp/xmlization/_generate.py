@@ -72,6 +72,26 @@ private static byte[] ReadWholeContentAsBase64(
     )
 
 
+def _generate_read_content_as_interned_string() -> Stripped:
+    """Generate the function to read the content as a string and intern it."""
+    return Stripped(
+        f"""\
+/// <summary>
+/// Read the content as a string and intern it with
+/// <see cref="Deserialize.Intern" />, if set.
+/// </summary>
+private static string ReadContentAsInternedString(
+{I}Xml.XmlReader reader)
+{{
+{I}string result = reader.ReadContentAsString();
+{I}var intern = Deserialize.Intern;
+{I}return (intern != null)
+{II}? intern(result)
+{II}: result;
+}}"""
+    )
+
+
 def _generate_extract_element_name() -> Stripped:
     """Generate the function to strip the prefix and check the namespace."""
     return Stripped(
@@ -137,7 +157,9 @@ def _generate_deserialize_primitive_property(
     elif a_type is intermediate.PrimitiveType.FLOAT:
         deserialization_expr = "reader.ReadContentAsDouble()"
     elif a_type is intermediate.PrimitiveType.STR:
-        deserialization_expr = "reader.ReadContentAsString()"
+        deserialization_expr = f"""\
+DeserializeImplementation.ReadContentAsInternedString(
+{I}reader)"""
     elif a_type is intermediate.PrimitiveType.BYTEARRAY:
         deserialization_expr = f"""\
 DeserializeImplementation.ReadWholeContentAsBase64(
@@ -1049,6 +1071,7 @@ def _generate_deserialize_impl(
     blocks = [
         _generate_skip_whitespace_and_comments(),
         _generate_read_whole_content_as_base_64(),
+        _generate_read_content_as_interned_string(),
         _generate_extract_element_name(),
     ]  # type: List[Stripped]
 
@@ -1223,7 +1246,20 @@ public static Aas.{name} {name}From(
 def _generate_deserialize(symbol_table: intermediate.SymbolTable) -> Stripped:
     """Generate the public class ``Deserialize``."""
 
-    blocks = []  # type: List[Stripped]
+    blocks = [
+        Stripped(
+            """\
+/// <summary>
+/// If set, intern the deserialized strings with this function.
+/// </summary>
+/// <remarks>
+/// Large data sets often repeat the same strings. Plug in, for example,
+/// <see cref="string.Intern" /> or your own pool so that all the occurrences
+/// of a string share a single instance.
+/// </remarks>
+public static System.Func<string, string>? Intern { get; set; }"""
+        )
+    ]  # type: List[Stripped]
     for our_type in symbol_table.our_types:
         if isinstance(our_type, intermediate.Enumeration):
             # NOTE (mristin, 2022-04-13):
@@ -0,0 +1 @@
+"""Generate a JSON documentation model of the meta-model."""
@@ -0,0 +1,718 @@
+"""Generate a JSON documentation model of the meta-model."""
+import collections
+import json
+from typing import TextIO, Any, MutableMapping, Optional, Tuple, List, Sequence
+
+import asttokens
+import docutils.nodes
+from icontract import ensure
+
+import aas_core_codegen.doc_model
+from aas_core_codegen import intermediate, run
+from aas_core_codegen.common import Stripped, Error, assert_never
+from aas_core_codegen.intermediate import (
+    doc as intermediate_doc,
+    rendering as intermediate_rendering,
+)
+from aas_core_codegen.parse import tree as parse_tree
+
+assert aas_core_codegen.doc_model.__doc__ == __doc__
+
+# We bump this version whenever the structure of the documentation model changes
+# so that the documentation sites and IDE plugins can check for compatibility.
+_FORMAT_VERSION = 3
+
+_JsonNode = MutableMapping[str, Any]
+
+
+class _ElementRenderer(intermediate_rendering.DocutilsElementTransformer[_JsonNode]):
+    """Render descriptions as a structured JSON tree."""
+
+    def _transform_children_of(
+        self, element: docutils.nodes.Element, node_type: str
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        """Transform the children and wrap them in a node of ``node_type``."""
+        children = []  # type: List[_JsonNode]
+        errors = []  # type: List[str]
+
+        for child in element.children:
+            rendered_child, child_errors = self.transform(child)
+            if child_errors is not None:
+                errors.extend(child_errors)
+            else:
+                assert rendered_child is not None
+                children.append(rendered_child)
+
+        if len(errors) > 0:
+            return None, errors
+
+        return (
+            collections.OrderedDict([("type", node_type), ("children", children)]),
+            None,
+        )
+
+    def transform_text(
+        self, element: docutils.nodes.Text
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict([("type", "text"), ("text", element.astext())]),
+            None,
+        )
+
+    def transform_reference_to_our_type_in_doc(
+        self, element: intermediate_doc.ReferenceToOurType
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict(
+                [
+                    ("type", "referenceToOurType"),
+                    ("ourType", element.our_type.name),
+                    ("text", element.astext()),
+                ]
+            ),
+            None,
+        )
+
+    def transform_reference_to_attribute_in_doc(
+        self, element: intermediate_doc.ReferenceToAttribute
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        if isinstance(element.reference, intermediate_doc.ReferenceToProperty):
+            return (
+                collections.OrderedDict(
+                    [
+                        ("type", "referenceToProperty"),
+                        ("class", element.reference.cls.name),
+                        ("property", element.reference.prop.name),
+                        ("text", element.astext()),
+                    ]
+                ),
+                None,
+            )
+
+        elif isinstance(
+            element.reference, intermediate_doc.ReferenceToEnumerationLiteral
+        ):
+            return (
+                collections.OrderedDict(
+                    [
+                        ("type", "referenceToEnumerationLiteral"),
+                        ("enumeration", element.reference.enumeration.name),
+                        ("literal", element.reference.literal.name),
+                        ("text", element.astext()),
+                    ]
+                ),
+                None,
+            )
+
+        else:
+            assert_never(element.reference)
+
+        raise AssertionError("Should not have gotten here")
+
+    def transform_reference_to_argument_in_doc(
+        self, element: intermediate_doc.ReferenceToArgument
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict(
+                [("type", "referenceToArgument"), ("argument", element.reference)]
+            ),
+            None,
+        )
+
+    def transform_reference_to_constraint_in_doc(
+        self, element: intermediate_doc.ReferenceToConstraint
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict(
+                [("type", "referenceToConstraint"), ("constraint", element.reference)]
+            ),
+            None,
+        )
+
+    def transform_reference_to_constant_in_doc(
+        self, element: intermediate_doc.ReferenceToConstant
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict(
+                [
+                    ("type", "referenceToConstant"),
+                    ("constant", element.constant.name),
+                    ("text", element.astext()),
+                ]
+            ),
+            None,
+        )
+
+    def transform_literal(
+        self, element: docutils.nodes.literal
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict([("type", "literal"), ("text", element.astext())]),
+            None,
+        )
+
+    def transform_literal_block(
+        self, element: docutils.nodes.literal_block
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return (
+            collections.OrderedDict(
+                [("type", "literalBlock"), ("text", element.astext())]
+            ),
+            None,
+        )
+
+    def transform_example(
+        self, element: intermediate_doc.Example
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "example")
+
+    def transform_paragraph(
+        self, element: docutils.nodes.paragraph
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "paragraph")
+
+    def transform_emphasis(
+        self, element: docutils.nodes.emphasis
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "emphasis")
+
+    def transform_list_item(
+        self, element: docutils.nodes.list_item
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "listItem")
+
+    def transform_bullet_list(
+        self, element: docutils.nodes.bullet_list
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "bulletList")
+
+    def transform_note(
+        self, element: docutils.nodes.note
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "note")
+
+    def transform_reference(
+        self, element: docutils.nodes.reference
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        node, errors = self._transform_children_of(element, "hyperlink")
+        if errors is not None:
+            return None, errors
+
+        assert node is not None
+        node["uri"] = element.get("refuri", None)
+        return node, None
+
+    def transform_field_body(
+        self, element: docutils.nodes.field_body
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "fieldBody")
+
+    def transform_document(
+        self, element: docutils.nodes.document
+    ) -> Tuple[Optional[_JsonNode], Optional[List[str]]]:
+        return self._transform_children_of(element, "document")
+
+
+def _render_elements(
+    elements: Sequence[docutils.nodes.Element],
+    description: intermediate.DescriptionUnion,
+    errors: List[Error],
+) -> List[_JsonNode]:
+    """Render the ``elements`` and append the errors, if any, to ``errors``."""
+    renderer = _ElementRenderer()
+
+    result = []  # type: List[_JsonNode]
+    for element in elements:
+        node, node_errors = renderer.transform(element)
+        if node_errors is not None:
+            errors.extend(
+                Error(description.parsed.node, message) for message in node_errors
+            )
+        else:
+            assert node is not None
+            result.append(node)
+
+    return result
+
+
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def _serialize_description(
+    description: intermediate.DescriptionUnion,
+) -> Tuple[Optional[_JsonNode], Optional[List[Error]]]:
+    """Serialize the ``description`` as a structured tree."""
+    errors = []  # type: List[Error]
+
+    result = collections.OrderedDict()  # type: _JsonNode
+
+    summary = _render_elements([description.summary], description, errors)
+    result["summary"] = summary[0] if len(summary) > 0 else None
+    result["remarks"] = _render_elements(description.remarks, description, errors)
+
+    if isinstance(description, intermediate.SummaryRemarksConstraintsDescription):
+        constraints = collections.OrderedDict()  # type: _JsonNode
+        for identifier, body in description.constraints_by_identifier.items():
+            rendered = _render_elements([body], description, errors)
+            constraints[identifier] = rendered[0] if len(rendered) > 0 else None
+
+        result["constraintsByIdentifier"] = constraints
+        result["since"] = description.since
+
+    if isinstance(description, intermediate.DescriptionOfSignature):
+        arguments = collections.OrderedDict()  # type: _JsonNode
+        for name, body in description.arguments_by_name.items():
+            rendered = _render_elements([body], description, errors)
+            arguments[name] = rendered[0] if len(rendered) > 0 else None
+
+        result["argumentsByName"] = arguments
+
+        if description.returns is not None:
+            rendered = _render_elements([description.returns], description, errors)
+            result["returns"] = rendered[0] if len(rendered) > 0 else None
+        else:
+            result["returns"] = None
+
+    if len(errors) > 0:
+        return None, errors
+
+    return result, None
+
+
+def _serialize_type_annotation(
+    type_annotation: intermediate.TypeAnnotationUnion,
+) -> _JsonNode:
+    """Serialize the ``type_annotation`` as a nested mapping."""
+    if isinstance(type_annotation, intermediate.PrimitiveTypeAnnotation):
+        return collections.OrderedDict(
+            [("type", "primitive"), ("primitive", type_annotation.a_type.value)]
+        )
+
+    elif isinstance(type_annotation, intermediate.OurTypeAnnotation):
+        return collections.OrderedDict(
+            [("type", "ourType"), ("ourType", type_annotation.our_type.name)]
+        )
+
+    elif isinstance(type_annotation, intermediate.ListTypeAnnotation):
+        return collections.OrderedDict(
+            [
+                ("type", "list"),
+                ("items", _serialize_type_annotation(type_annotation.items)),
+            ]
+        )
+
+    elif isinstance(type_annotation, intermediate.OptionalTypeAnnotation):
+        return collections.OrderedDict(
+            [
+                ("type", "optional"),
+                ("value", _serialize_type_annotation(type_annotation.value)),
+            ]
+        )
+
+    else:
+        assert_never(type_annotation)
+
+    raise AssertionError("Should not have gotten here")
+
+
+def _serialize_default(default: Optional[intermediate.Default]) -> Optional[_JsonNode]:
+    """Serialize the ``default`` of an argument, if any."""
+    if default is None:
+        return None
+
+    if isinstance(default, intermediate.DefaultPrimitive):
+        return collections.OrderedDict(
+            [("type", "primitive"), ("value", default.value)]
+        )
+
+    elif isinstance(default, intermediate.DefaultEnumerationLiteral):
+        return collections.OrderedDict(
+            [
+                ("type", "enumerationLiteral"),
+                ("enumeration", default.enumeration.name),
+                ("literal", default.literal.name),
+            ]
+        )
+
+    else:
+        assert_never(default)
+
+    raise AssertionError("Should not have gotten here")
+
+
+def _serialize_arguments(
+    arguments: Sequence[intermediate.Argument],
+) -> List[_JsonNode]:
+    """Serialize the arguments of a signature."""
+    return [
+        collections.OrderedDict(
+            [
+                ("name", arg.name),
+                ("type", _serialize_type_annotation(arg.type_annotation)),
+                ("default", _serialize_default(arg.default)),
+            ]
+        )
+        for arg in arguments
+    ]
+
+
+_COMPARATOR_TO_TEXT = {
+    parse_tree.Comparator.LT: "<",
+    parse_tree.Comparator.LE: "≤",
+    parse_tree.Comparator.GT: ">",
+    parse_tree.Comparator.GE: "≥",
+    parse_tree.Comparator.EQ: "=",
+    parse_tree.Comparator.NE: "≠",
+}
+assert all(op in _COMPARATOR_TO_TEXT for op in parse_tree.Comparator)
+
+
+class _PseudocodeRenderer(parse_tree.Transformer[str]):
+    """Render the body of an invariant as human-readable pseudocode."""
+
+    def _wrap(self, node: parse_tree.Node) -> str:
+        """Render ``node`` and put it in parentheses if it is a compound."""
+        text = self.transform(node)
+        if isinstance(
+            node,
+            (
+                parse_tree.And,
+                parse_tree.Or,
+                parse_tree.Implication,
+                parse_tree.Any,
+                parse_tree.All,
+            ),
+        ):
+            return f"({text})"
+
+        return text
+
+    def transform_member(self, node: parse_tree.Member) -> str:
+        # The invariants are always stated on ``self``, so we omit it for brevity.
+        if (
+            isinstance(node.instance, parse_tree.Name)
+            and node.instance.identifier == "self"
+        ):
+            return node.name
+
+        return f"{self._wrap(node.instance)}.{node.name}"
+
+    def transform_index(self, node: parse_tree.Index) -> str:
+        return f"{self._wrap(node.collection)}[{self.transform(node.index)}]"
+
+    def transform_comparison(self, node: parse_tree.Comparison) -> str:
+        return (
+            f"{self._wrap(node.left)} {_COMPARATOR_TO_TEXT[node.op]} "
+            f"{self._wrap(node.right)}"
+        )
+
+    def transform_is_in(self, node: parse_tree.IsIn) -> str:
+        return f"{self._wrap(node.member)} is one of {self._wrap(node.container)}"
+
+    def transform_implication(self, node: parse_tree.Implication) -> str:
+        return (
+            f"if {self.transform(node.antecedent)}, "
+            f"then {self._wrap(node.consequent)}"
+        )
+
+    def transform_method_call(self, node: parse_tree.MethodCall) -> str:
+        args = ", ".join(self.transform(arg) for arg in node.args)
+        return f"{self.transform(node.member)}({args})"
+
+    def transform_function_call(self, node: parse_tree.FunctionCall) -> str:
+        if node.name.identifier == "len" and len(node.args) == 1:
+            return f"length of {self._wrap(node.args[0])}"
+
+        args = ", ".join(self.transform(arg) for arg in node.args)
+        return f"{node.name.identifier}({args})"
+
+    def transform_constant(self, node: parse_tree.Constant) -> str:
+        if isinstance(node.value, bool):
+            return "true" if node.value else "false"
+
+        elif isinstance(node.value, str):
+            return json.dumps(node.value, ensure_ascii=False)
+
+        else:
+            return str(node.value)
+
+    def transform_is_none(self, node: parse_tree.IsNone) -> str:
+        return f"{self._wrap(node.value)} is not specified"
+
+    def transform_is_not_none(self, node: parse_tree.IsNotNone) -> str:
+        return f"{self._wrap(node.value)} is specified"
+
+    def transform_name(self, node: parse_tree.Name) -> str:
+        return node.identifier
+
+    def transform_not(self, node: parse_tree.Not) -> str:
+        return f"not {self._wrap(node.operand)}"
+
+    def transform_and(self, node: parse_tree.And) -> str:
+        return " and ".join(self._wrap(value) for value in node.values)
+
+    def transform_or(self, node: parse_tree.Or) -> str:
+        return " or ".join(self._wrap(value) for value in node.values)
+
+    def transform_add(self, node: parse_tree.Add) -> str:
+        return f"{self._wrap(node.left)} + {self._wrap(node.right)}"
+
+    def transform_sub(self, node: parse_tree.Sub) -> str:
+        return f"{self._wrap(node.left)} - {self._wrap(node.right)}"
+
+    def transform_formatted_value(self, node: parse_tree.FormattedValue) -> str:
+        return f"{{{self.transform(node.value)}}}"
+
+    def transform_joined_str(self, node: parse_tree.JoinedStr) -> str:
+        parts = [
+            value if isinstance(value, str) else self.transform(value)
+            for value in node.values
+        ]
+        return f'"{"".join(parts)}"'
+
+    def transform_for_each(self, node: parse_tree.ForEach) -> str:
+        return f"{node.variable.identifier} in {self._wrap(node.iteration)}"
+
+    def transform_for_range(self, node: parse_tree.ForRange) -> str:
+        return (
+            f"{node.variable.identifier} from {self._wrap(node.start)} "
+            f"up to (excluding) {self._wrap(node.end)}"
+        )
+
+    def transform_any(self, node: parse_tree.Any) -> str:
+        return (
+            f"for at least one {self.transform(node.generator)}: "
+            f"{self.transform(node.condition)}"
+        )
+
+    def transform_all(self, node: parse_tree.All) -> str:
+        return (
+            f"for every {self.transform(node.generator)}: "
+            f"{self.transform(node.condition)}"
+        )
+
+    def transform_assignment(self, node: parse_tree.Assignment) -> str:
+        return f"{self.transform(node.target)} := {self.transform(node.value)}"
+
+    def transform_return(self, node: parse_tree.Return) -> str:
+        if node.value is None:
+            return "return"
+
+        return f"return {self.transform(node.value)}"
+
+
+def _render_pseudocode(body: parse_tree.Expression) -> str:
+    """Render the ``body`` of an invariant as human-readable pseudocode."""
+    return _PseudocodeRenderer().transform(body)
+
+
+def _serialize_invariants(
+    invariants: Sequence[intermediate.Invariant], atok: asttokens.ASTTokens
+) -> List[_JsonNode]:
+    """Serialize the invariants together with their source code and pseudocode."""
+    return [
+        collections.OrderedDict(
+            [
+                ("description", invariant.description),
+                ("specifiedFor", invariant.specified_for.name),
+                ("source", atok.get_text(invariant.parsed.node)),
+                ("pseudocode", _render_pseudocode(invariant.body)),
+            ]
+        )
+        for invariant in invariants
+    ]
+
+
+class _Serializer:
+    """Serialize the descriptions and collect the errors on the way."""
+
+    def __init__(self) -> None:
+        """Initialize with no errors."""
+        self.errors = []  # type: List[Error]
+
+    def description(
+        self, description: Optional[intermediate.DescriptionUnion]
+    ) -> Optional[_JsonNode]:
+        """Serialize the ``description``, if any, and remember the errors."""
+        if description is None:
+            return None
+
+        result, errors = _serialize_description(description)
+        if errors is not None:
+            self.errors.extend(errors)
+            return None
+
+        return result
+
+
+def _serialize_our_type(
+    our_type: intermediate.OurType,
+    serializer: _Serializer,
+    atok: asttokens.ASTTokens,
+) -> _JsonNode:
+    """Serialize ``our_type`` with all its documentation."""
+    result = collections.OrderedDict()  # type: _JsonNode
+
+    if isinstance(our_type, intermediate.Enumeration):
+        result["kind"] = "enumeration"
+        result["name"] = our_type.name
+        result["description"] = serializer.description(our_type.description)
+        result["literals"] = [
+            collections.OrderedDict(
+                [
+                    ("name", literal.name),
+                    ("value", literal.value),
+                    ("description", serializer.description(literal.description)),
+                ]
+            )
+            for literal in our_type.literals
+        ]
+
+    elif isinstance(our_type, intermediate.ConstrainedPrimitive):
+        result["kind"] = "constrainedPrimitive"
+        result["name"] = our_type.name
+        result["description"] = serializer.description(our_type.description)
+        result["constrainee"] = our_type.constrainee.value
+        result["inheritances"] = [
+            inheritance.name for inheritance in our_type.inheritances
+        ]
+        result["invariants"] = _serialize_invariants(our_type.invariants, atok)
+
+    elif isinstance(our_type, (intermediate.AbstractClass, intermediate.ConcreteClass)):
+        result["kind"] = (
+            "abstractClass"
+            if isinstance(our_type, intermediate.AbstractClass)
+            else "concreteClass"
+        )
+        result["name"] = our_type.name
+        result["description"] = serializer.description(our_type.description)
+        result["inheritances"] = [
+            inheritance.name for inheritance in our_type.inheritances
+        ]
+        result["concreteDescendants"] = [
+            descendant.name for descendant in our_type.concrete_descendants
+        ]
+        result["properties"] = [
+            collections.OrderedDict(
+                [
+                    ("name", prop.name),
+                    ("type", _serialize_type_annotation(prop.type_annotation)),
+                    ("specifiedFor", prop.specified_for.name),
+                    ("description", serializer.description(prop.description)),
+                ]
+            )
+            for prop in our_type.properties
+        ]
+        result["constructor"] = collections.OrderedDict(
+            [("arguments", _serialize_arguments(our_type.constructor.arguments))]
+        )
+        result["invariants"] = _serialize_invariants(our_type.invariants, atok)
+
+    else:
+        assert_never(our_type)
+
+    if our_type.reference_in_the_book is not None:
+        result["referenceInTheBook"] = collections.OrderedDict(
+            [
+                ("section", list(our_type.reference_in_the_book.section)),
+                ("index", our_type.reference_in_the_book.index),
+                ("fragment", our_type.reference_in_the_book.fragment),
+            ]
+        )
+    else:
+        result["referenceInTheBook"] = None
+
+    return result
+
+
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def _generate(
+    symbol_table: intermediate.SymbolTable, atok: asttokens.ASTTokens
+) -> Tuple[Optional[Stripped], Optional[List[Error]]]:
+    """Generate the documentation model as JSON text."""
+    serializer = _Serializer()
+
+    meta_model = collections.OrderedDict()  # type: _JsonNode
+    meta_model["bookUrl"] = symbol_table.meta_model.book_url
+    meta_model["bookVersion"] = symbol_table.meta_model.book_version
+    meta_model["description"] = serializer.description(
+        symbol_table.meta_model.description
+    )
+
+    our_types = [
+        _serialize_our_type(our_type=our_type, serializer=serializer, atok=atok)
+        for our_type in symbol_table.our_types
+    ]
+
+    constants = [
+        collections.OrderedDict(
+            [
+                ("name", constant.name),
+                ("kind", constant.__class__.__name__),
+                ("description", serializer.description(constant.description)),
+            ]
+        )
+        for constant in symbol_table.constants
+    ]
+
+    verification_functions = [
+        collections.OrderedDict(
+            [
+                ("name", verification.name),
+                ("arguments", _serialize_arguments(verification.arguments)),
+                (
+                    "returns",
+                    _serialize_type_annotation(verification.returns)
+                    if verification.returns is not None
+                    else None,
+                ),
+                ("description", serializer.description(verification.description)),
+            ]
+        )
+        for verification in symbol_table.verification_functions
+    ]
+
+    if len(serializer.errors) > 0:
+        return None, serializer.errors
+
+    model = collections.OrderedDict()  # type: _JsonNode
+    model["formatVersion"] = _FORMAT_VERSION
+    model["metaModel"] = meta_model
+    model["ourTypes"] = our_types
+    model["constants"] = constants
+    model["verificationFunctions"] = verification_functions
+
+    return Stripped(json.dumps(model, indent=2)), None
+
+
+def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
+    """Generate the documentation model."""
+    code, errors = _generate(
+        symbol_table=context.symbol_table, atok=context.lineno_columner.atok
+    )
+
+    if errors is not None:
+        run.write_error_report(
+            message=f"Failed to generate the documentation model "
+            f"based on {context.model_path}",
+            errors=[context.lineno_columner.error_message(error) for error in errors],
+            stderr=stderr,
+        )
+        return 1
+
+    assert code is not None
+
+    pth = context.output_dir / "doc_model.json"
+    try:
+        context.write_text(path=pth, text=code + "\n")
+    except Exception as exception:
+        run.write_error_report(
+            message=f"Failed to write the documentation model to {pth}",
+            errors=[str(exception)],
+            stderr=stderr,
+        )
+        return 1
+
+    context.logger.info(
+        f"Code generated to: {context.output_dir}",
+        output_dir=str(context.output_dir),
+    )
+    return 0
@@ -0,0 +1 @@
+"""Explain how the generated artifacts enforce a constraint of the meta-model."""
@@ -0,0 +1,198 @@
+"""Explain how the generated artifacts enforce a constraint of the meta-model."""
+
+import argparse
+import collections
+import io
+import pathlib
+import sys
+from typing import TextIO, Any, List, MutableMapping, Tuple, Mapping
+
+import aas_core_codegen
+from aas_core_codegen import parse, run, intermediate
+from aas_core_codegen.common import LinenoColumner, Stripped
+from aas_core_codegen.constraints_report import main as constraints_report_main
+import aas_core_codegen.explain
+
+assert __doc__ == aas_core_codegen.explain.__doc__
+
+#: Identify an invariant by the class where it is specified and its source code
+_InvariantKey = Tuple[str, str]
+
+
+def _explain(
+    identifier: str, report: Mapping[str, Any], traceability: Mapping[str, Any]
+) -> Stripped:
+    """Render the explanation of the constraint ``identifier``."""
+    # The report lists the invariants for each class, including the inherited ones.
+    # We group them by the class where they are specified so that each invariant is
+    # explained only once together with all the classes it applies to.
+    invariants = collections.OrderedDict()  # type: MutableMapping[_InvariantKey, Any]
+    classes_by_invariant = (
+        collections.OrderedDict()
+    )  # type: MutableMapping[_InvariantKey, List[str]]
+
+    for cls in report["classes"]:
+        for invariant in cls["invariants"]:
+            invariant_identifier = constraints_report_main.constraint_identifier(
+                invariant["description"]
+            )
+            if invariant_identifier != identifier:
+                continue
+
+            key = (invariant["specifiedFor"], invariant["source"])
+            invariants.setdefault(key, invariant)
+            classes_by_invariant.setdefault(key, []).append(cls["name"])
+
+    writer = io.StringIO()
+    writer.write(f"Constraint {identifier}\n")
+
+    if len(invariants) == 0:
+        writer.write("\nNo invariant in the meta-model enforces this constraint.\n")
+
+    for key, invariant in invariants.items():
+        writer.write(
+            f"""
+Invariant specified for {invariant['specifiedFor']}:
+  Description: {invariant['description']}
+  Source: {' '.join(invariant['source'].split())}
+  Applies to: {', '.join(classes_by_invariant[key])}
+  Status:
+"""
+        )
+
+        for target, status in invariant["statusByTarget"].items():
+            if status == constraints_report_main.SNIPPET:
+                snippets = ", ".join(
+                    f"Verification/{name}.cs" for name in invariant["snippets"]
+                )
+                writer.write(f"    {target}: {status} ({snippets})\n")
+            else:
+                writer.write(f"    {target}: {status}\n")
+
+    constructs = traceability["constructsByConstraint"].get(identifier, [])
+    if len(constructs) > 0:
+        writer.write("\nGenerated constructs:\n")
+        for construct in constructs:
+            writer.write(
+                f"  {construct['target']}: {construct['file']} {construct['symbol']}\n"
+            )
+
+    return Stripped(writer.getvalue().strip())
+
+
+def execute(
+    model_path: pathlib.Path, identifier: str, stdout: TextIO, stderr: TextIO
+) -> int:
+    """Explain how the constraint ``identifier`` is enforced."""
+    text = model_path.read_text(encoding="utf-8-sig")
+
+    atok, parse_exception = parse.source_to_atok(source=text)
+    if parse_exception:
+        if isinstance(parse_exception, SyntaxError):
+            stderr.write(
+                f"Failed to parse the meta-model {model_path}: "
+                f"invalid syntax at line {parse_exception.lineno}\n"
+            )
+        else:
+            stderr.write(
+                f"Failed to parse the meta-model {model_path}: {parse_exception}\n"
+            )
+
+        return 1
+
+    import_errors = parse.check_expected_imports(atok=atok)
+    if import_errors:
+        run.write_error_report(
+            message="One or more unexpected imports in the meta-model",
+            errors=import_errors,
+            stderr=stderr,
+        )
+
+        return 1
+
+    lineno_columner = LinenoColumner(atok=atok)
+
+    parsed_symbol_table, error = parse.atok_to_symbol_table(atok=atok)
+    if error is not None:
+        run.write_error_report(
+            message=f"Failed to construct the symbol table from {model_path}",
+            errors=[lineno_columner.error_message(error)],
+            stderr=stderr,
+        )
+
+        return 1
+
+    assert parsed_symbol_table is not None
+
+    symbol_table, error = intermediate.translate(
+        parsed_symbol_table=parsed_symbol_table,
+        atok=atok,
+    )
+    if error is not None:
+        run.write_error_report(
+            message=f"Failed to translate the parsed symbol table "
+            f"to intermediate symbol table "
+            f"based on {model_path}",
+            errors=[lineno_columner.error_message(error)],
+            stderr=stderr,
+        )
+
+        return 1
+
+    assert symbol_table is not None
+
+    report = constraints_report_main.generate_report(
+        symbol_table=symbol_table, atok=atok
+    )
+    traceability = constraints_report_main.generate_traceability(
+        symbol_table=symbol_table
+    )
+
+    if identifier not in traceability["constructsByConstraint"]:
+        stderr.write(
+            f"The constraint {identifier} is neither declared nor enforced "
+            f"in the meta-model {model_path}\n"
+        )
+        return 1
+
+    stdout.write(
+        _explain(identifier=identifier, report=report, traceability=traceability)
+    )
+    stdout.write("\n")
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument("--model_path", help="path to the meta-model", required=True)
+    parser.add_argument(
+        "identifier", help="identifier of the constraint such as AASd-022"
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    return execute(
+        model_path=pathlib.Path(args.model_path),
+        identifier=args.identifier,
+        stdout=sys.stdout,
+        stderr=sys.stderr,
+    )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-explain")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-explain"))
@@ -1,6 +1,7 @@
 """Infer constraints representable in common schemas such as JSON Schema or XSD."""
 
 from aas_core_codegen.infer_for_schema import (
+    _enforcement,
     _len,
     _pattern,
     _inline,
@@ -17,4 +18,8 @@ SetOfEnumerationLiteralsConstraint = _types.SetOfEnumerationLiteralsConstraint
 infer_constraints_by_class = _inline.infer_constraints_by_class
 merge_constraints_with_ancestors = _inline.merge_constraints_with_ancestors
 
+PatternVerificationsByName = _pattern.PatternVerificationsByName
+map_pattern_verifications_by_name = _pattern.map_pattern_verifications_by_name
+is_enforced_by_schema = _enforcement.is_enforced_by_schema
+
 dump = _stringify.dump
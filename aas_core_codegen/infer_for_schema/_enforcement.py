@@ -0,0 +1,90 @@
+"""Decide whether an invariant is completely enforced by the inferred constraints."""
+from typing import Sequence
+
+from aas_core_codegen import intermediate
+from aas_core_codegen.infer_for_schema import (
+    _common as infer_for_schema_common,
+    _len as infer_for_schema_len,
+    _pattern as infer_for_schema_pattern,
+)
+from aas_core_codegen.parse import tree as parse_tree
+
+
+def _split_conjunction(node: parse_tree.Expression) -> Sequence[parse_tree.Expression]:
+    """Split ``node`` in its values if it is a conjunction."""
+    if isinstance(node, parse_tree.And):
+        return node.values
+
+    return [node]
+
+
+def _is_len_on(node: parse_tree.Expression, name: str) -> bool:
+    """Check that ``node`` constrains ``len`` of ``self`` or of a property."""
+    # pylint: disable=protected-access
+    mtch = infer_for_schema_len._match_len_constraint_on_member_or_name(node)
+    if mtch is None:
+        return False
+
+    if name == "self":
+        return (
+            isinstance(mtch.member_or_name, parse_tree.Name)
+            and mtch.member_or_name.identifier == "self"
+        )
+
+    return infer_for_schema_common.match_property(mtch.member_or_name) is not None
+
+
+def is_enforced_by_schema(
+    invariant: intermediate.Invariant,
+    pattern_verifications_by_name: infer_for_schema_pattern.PatternVerificationsByName,
+) -> bool:
+    """
+    Check whether the ``invariant`` is completely captured by the inferred constraints.
+
+    The schemas represent only the constraints on the length and the patterns, and
+    ignore the remainder of the invariants. This function mirrors the matching of
+    :py:func:`infer_constraints_by_class` so that we can tell which invariants are
+    dropped in the schemas.
+    """
+    # pylint: disable=protected-access
+
+    if isinstance(invariant.specified_for, intermediate.ConstrainedPrimitive):
+        if _is_len_on(invariant.body, "self"):
+            return True
+
+        if invariant.specified_for.constrainee is not intermediate.PrimitiveType.STR:
+            return False
+
+        return all(
+            infer_for_schema_pattern._match_pattern_on_self(
+                node=value_node,
+                pattern_verifications_by_name=pattern_verifications_by_name,
+            )
+            is not None
+            for value_node in _split_conjunction(invariant.body)
+        )
+
+    node = invariant.body
+    prop_name = None
+
+    conditional_on_prop = infer_for_schema_common.match_conditional_on_prop(node)
+    if conditional_on_prop is not None:
+        node = conditional_on_prop.consequent
+        prop_name = conditional_on_prop.prop_name
+
+    if _is_len_on(node, "property"):
+        return True
+
+    for value_node in _split_conjunction(node):
+        constraint_on_prop = infer_for_schema_pattern._match_constraint_on_property(
+            node=value_node,
+            pattern_verifications_by_name=pattern_verifications_by_name,
+        )
+
+        if constraint_on_prop is None:
+            return False
+
+        if prop_name is not None and constraint_on_prop.prop_name != prop_name:
+            return False
+
+    return True
@@ -0,0 +1 @@
+"""Scaffold the conventional layout of an SDK repository generated by aas-core-codegen."""
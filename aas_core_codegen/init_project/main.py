@@ -0,0 +1,199 @@
+"""Scaffold the conventional layout of an SDK repository generated by aas-core-codegen."""
+
+import argparse
+import pathlib
+import sys
+from typing import TextIO, Mapping, List
+
+import aas_core_codegen
+import aas_core_codegen.main
+from aas_core_codegen import run
+from aas_core_codegen.common import Stripped
+import aas_core_codegen.init_project
+
+assert __doc__ == aas_core_codegen.init_project.__doc__
+
+_README = """\
+# SDK for the Asset Administration Shell in {target}
+
+This repository follows the conventional layout of the SDKs generated by
+[aas-core-codegen](https://github.com/aas-core-works/aas-core-codegen):
+
+* `src/` contains the generated code. Do not edit it by hand, but re-generate it.
+* `snippets/` contains the implementation-specific snippets, laid out as
+  `{{our type or function}}.{{extension}}` or as `Verification/{{function}}.py`
+  for the shared snippets.
+* `tests/` contains the tests of the generated code.
+* `generate.py` re-generates `src/` based on the meta-model.
+
+Re-generate the code with:
+
+```
+python generate.py
+```"""
+
+_GENERATE = '''\
+"""Generate the code of the SDK based on the meta-model."""
+
+import os
+import pathlib
+import subprocess
+import sys
+
+import aas_core_meta.v3rc2
+
+
+def main() -> int:
+    """Execute the main routine."""
+    repo_dir = pathlib.Path(os.path.realpath(__file__)).parent
+
+    return subprocess.call(
+        [
+            sys.executable,
+            "-m",
+            "aas_core_codegen",
+            "--model_path",
+            aas_core_meta.v3rc2.__file__,
+            "--snippets_dir",
+            str(repo_dir / "snippets"),
+            "--output_dir",
+            str(repo_dir / "src"),
+            "--target",
+            "{target}",
+        ]
+    )
+
+
+if __name__ == "__main__":
+    sys.exit(main())'''
+
+_CI = """\
+name: CI
+
+on:
+  push:
+    branches: [ master, main ]
+  pull_request:
+    branches: [ "**" ]
+
+jobs:
+  Execute-continuous-integration:
+    runs-on: ubuntu-latest
+
+    steps:
+      - uses: actions/checkout@master
+
+      - name: Set up Python
+        uses: actions/setup-python@v2
+        with:
+          python-version: '3.8'
+
+      - name: Install the generator
+        run: |
+          python -m pip install --upgrade pip
+          python -m pip install aas-core-codegen aas-core-meta
+
+      - name: Check that the generated code is up-to-date
+        run: |
+          python generate.py
+          git diff --exit-code
+
+      # Add here the build and the tests of your SDK."""
+
+_GITKEEP = Stripped("")
+
+
+def scaffold(target: aas_core_codegen.main.Target) -> Mapping[pathlib.Path, Stripped]:
+    """Map the relative paths of the scaffolded files for ``target`` to their content."""
+    return {
+        pathlib.Path("README.md"): Stripped(_README.format(target=target.value)),
+        pathlib.Path("generate.py"): Stripped(_GENERATE.format(target=target.value)),
+        pathlib.Path(".github") / "workflows" / "ci.yml": Stripped(_CI),
+        pathlib.Path("src") / ".gitkeep": _GITKEEP,
+        pathlib.Path("snippets") / ".gitkeep": _GITKEEP,
+        pathlib.Path("tests") / ".gitkeep": _GITKEEP,
+    }
+
+
+def execute(
+    target: aas_core_codegen.main.Target,
+    output_dir: pathlib.Path,
+    stdout: TextIO,
+    stderr: TextIO,
+) -> int:
+    """Scaffold the SDK repository for ``target`` in ``output_dir``."""
+    files = scaffold(target=target)
+
+    # We refuse to overwrite anything so that an accidental call on an existing
+    # repository does not destroy any work.
+    existing = [
+        str(output_dir / relative_pth)
+        for relative_pth in files
+        if (output_dir / relative_pth).exists()
+    ]  # type: List[str]
+
+    if len(existing) > 0:
+        run.write_error_report(
+            message=f"Refusing to overwrite the existing files in {output_dir}",
+            errors=existing,
+            stderr=stderr,
+        )
+        return 1
+
+    for relative_pth, content in files.items():
+        pth = output_dir / relative_pth
+
+        try:
+            run.extended_length_path(pth.parent).mkdir(parents=True, exist_ok=True)
+            run.write_text(path=pth, text=(content + "\n") if content != "" else "")
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the scaffold to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    stdout.write(f"Project scaffolded to: {output_dir}\n")
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument(
+        "--target",
+        help="target language or schema of the SDK",
+        required=True,
+        choices=[literal.value for literal in aas_core_codegen.main.Target],
+    )
+    parser.add_argument(
+        "--output_dir", help="path to the SDK repository", required=True
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    return execute(
+        target=aas_core_codegen.main.Target(args.target),
+        output_dir=pathlib.Path(args.output_dir),
+        stdout=sys.stdout,
+        stderr=sys.stderr,
+    )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-init")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-init"))
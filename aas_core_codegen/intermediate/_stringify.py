@@ -125,6 +125,8 @@ def _stringify_description_of_meta_model(
                     for identifier, body in that.constraints_by_identifier.items()
                 ],
             ),
+            stringify_mod.Property("renamed_from", that.renamed_from),
+            stringify_mod.Property("since", that.since),
             stringify_mod.PropertyEllipsis("parsed", that.parsed),
         ],
     )
@@ -147,6 +149,8 @@ def _stringify_description_of_our_type(
                     for identifier, body in that.constraints_by_identifier.items()
                 ],
             ),
+            stringify_mod.Property("renamed_from", that.renamed_from),
+            stringify_mod.Property("since", that.since),
             stringify_mod.PropertyEllipsis("parsed", that.parsed),
         ],
     )
@@ -169,6 +173,8 @@ def _stringify_description_of_property(
                     for identifier, body in that.constraints_by_identifier.items()
                 ],
             ),
+            stringify_mod.Property("renamed_from", that.renamed_from),
+            stringify_mod.Property("since", that.since),
             stringify_mod.PropertyEllipsis("parsed", that.parsed),
         ],
     )
@@ -242,6 +248,7 @@ def _stringify_property(
                 f"Reference to {that.specified_for.__class__.__name__} "
                 f"{that.specified_for.name}",
             ),
+            stringify_mod.Property("access_controlled", that.access_controlled),
             stringify_mod.PropertyEllipsis("parsed", that.parsed),
         ],
     )
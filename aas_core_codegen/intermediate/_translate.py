@@ -25,6 +25,7 @@ from typing import (
 import asttokens
 import docutils.nodes
 import docutils.parsers.rst
+import docutils.parsers.rst.directives
 import docutils.utils
 from icontract import require, ensure, snapshot
 
@@ -310,6 +311,25 @@ docutils.parsers.rst.roles.register_local_role(
 docutils.parsers.rst.roles.register_local_role("const", _role_reference_to_constant)
 
 
+class _ExampleDirective(docutils.parsers.rst.Directive):
+    """Parse the content of ``.. example::`` as an example in the documentation."""
+
+    has_content = True
+
+    def run(self) -> List[docutils.nodes.Node]:
+        """Parse the content as nested reStructuredText."""
+        # See: https://docutils.sourceforge.io/docs/howto/rst-directives.html
+        self.assert_has_content()
+
+        node = doc.Example("\n".join(self.content))
+        self.state.nested_parse(self.content, self.content_offset, node)
+        return [node]
+
+
+# noinspection PyUnresolvedReferences
+docutils.parsers.rst.directives.register_directive("example", _ExampleDirective)
+
+
 # region Descriptions
 
 
@@ -543,7 +563,40 @@ def _to_summary_remarks_constraints_description(
         collections.OrderedDict()
     )  # type: OrderedDict[str, docutils.nodes.field_body]
 
+    renamed_from = None  # type: Optional[Identifier]
+    since = None  # type: Optional[str]
+
     for name, body in structured_desc.fields_by_name.items():
+        if name == "renamed_from":
+            old_name = body.astext().strip()
+            if not IDENTIFIER_RE.fullmatch(old_name):
+                errors.append(
+                    Error(
+                        parsed.node,
+                        f"Expected the previous name in ``renamed_from`` "
+                        f"to be a valid identifier, but got: {old_name!r}",
+                    )
+                )
+                continue
+
+            renamed_from = Identifier(old_name)
+            continue
+
+        if name == "since":
+            version = body.astext().strip()
+            if version == "" or "\n" in version:
+                errors.append(
+                    Error(
+                        parsed.node,
+                        f"Expected the version in ``since`` to be a single line, "
+                        f"but got: {version!r}",
+                    )
+                )
+                continue
+
+            since = version
+            continue
+
         parts = name.split()
         if len(parts) != 2:
             errors.append(
@@ -591,6 +644,8 @@ def _to_summary_remarks_constraints_description(
             summary=structured_desc.summary,
             remarks=structured_desc.remarks,
             constraints_by_identifier=constraints_by_identifier,
+            renamed_from=renamed_from,
+            since=since,
             parsed=parsed,
         ),
         None,
@@ -601,9 +656,33 @@ def _to_description_of_meta_model(
     parsed: parse.Description,
 ) -> Tuple[Optional[DescriptionOfMetaModel], Optional[List[Error]]]:
     """Structure the information from the docstring of the meta-model."""
-    return _to_summary_remarks_constraints_description(
+    description, errors = _to_summary_remarks_constraints_description(
         parsed=parsed, factory=DescriptionOfMetaModel
     )
+    if errors is not None:
+        return None, errors
+
+    assert description is not None
+
+    if description.renamed_from is not None:
+        return None, [
+            Error(
+                parsed.node,
+                "Unexpected ``renamed_from`` in the description of the meta-model; "
+                "only our types and properties can be renamed",
+            )
+        ]
+
+    if description.since is not None:
+        return None, [
+            Error(
+                parsed.node,
+                "Unexpected ``since`` in the description of the meta-model; "
+                "only our types and properties can be introduced in a version",
+            )
+        ]
+
+    return description, None
 
 
 def _to_description_of_our_type(
@@ -1048,6 +1127,7 @@ def _to_property(
             # created. Therefore, we assign here a placeholder and fix it later
             # in a second pass.
             specified_for=_PlaceholderOurType(parsed_cls.name),  # type: ignore
+            access_controlled=parsed.access_controlled,
             parsed=parsed,
         ),
         None,
@@ -4221,6 +4301,62 @@ def _verify_constraints_and_constraintrefs(symbol_table: SymbolTable) -> List[Er
     return errors
 
 
+def _verify_renames(symbol_table: SymbolTable) -> List[Error]:
+    """Check that the previous names do not collide with the current ones."""
+    errors = []  # type: List[Error]
+
+    observed_type_names = {
+        our_type.name for our_type in symbol_table.our_types
+    }  # type: Set[Identifier]
+
+    for our_type in symbol_table.our_types:
+        if (
+            our_type.description is not None
+            and our_type.description.renamed_from is not None
+        ):
+            old_name = our_type.description.renamed_from
+            if old_name in observed_type_names:
+                errors.append(
+                    Error(
+                        our_type.description.parsed.node,
+                        f"The previous name {old_name!r} of our type "
+                        f"{our_type.name!r} collides with another name "
+                        f"of our type",
+                    )
+                )
+            else:
+                observed_type_names.add(old_name)
+
+        if isinstance(our_type, (Enumeration, ConstrainedPrimitive)):
+            continue
+
+        observed_prop_names = {
+            prop.name for prop in our_type.properties
+        }  # type: Set[Identifier]
+
+        for prop in our_type.properties:
+            if prop.specified_for is not our_type:
+                continue
+
+            if prop.description is None or prop.description.renamed_from is None:
+                continue
+
+            old_name = prop.description.renamed_from
+            if old_name in observed_prop_names:
+                errors.append(
+                    Error(
+                        prop.description.parsed.node,
+                        f"The previous name {old_name!r} of the property "
+                        f"{prop.name!r} collides with another property name "
+                        f"in our type {our_type.name!r}",
+                    )
+                )
+            else:
+                observed_prop_names.add(old_name)
+
+    return errors
+
+
 def _verify_description_rendering_with_smoke(symbol_table: SymbolTable) -> List[Error]:
     """Check that we can smoke-render all the descriptions."""
 
@@ -4292,6 +4428,16 @@ def _verify_description_rendering_with_smoke(symbol_table: SymbolTable) -> List[
         ) -> Tuple[Optional[bool], Optional[List[str]]]:
             return True, None
 
+        def transform_literal_block(
+            self, element: docutils.nodes.literal_block
+        ) -> Tuple[Optional[bool], Optional[List[str]]]:
+            return True, None
+
+        def transform_example(
+            self, element: doc.Example
+        ) -> Tuple[Optional[bool], Optional[List[str]]]:
+            return True, None
+
         def transform_field_body(
             self, element: docutils.nodes.field_body
         ) -> Tuple[Optional[bool], Optional[List[str]]]:
@@ -4498,6 +4644,8 @@ def _verify(symbol_table: SymbolTable, ontology: _hierarchy.Ontology) -> List[Er
 
     errors.extend(_verify_constraints_and_constraintrefs(symbol_table=symbol_table))
 
+    errors.extend(_verify_renames(symbol_table=symbol_table))
+
     errors.extend(_verify_description_rendering_with_smoke(symbol_table=symbol_table))
 
     errors.extend(_verify_only_simple_type_patterns(symbol_table=symbol_table))
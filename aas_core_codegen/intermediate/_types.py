@@ -303,6 +303,12 @@ class SummaryRemarksConstraintsDescription(SummaryRemarksDescription):
     #: Map constraint documentation elements by their identifiers
     constraints_by_identifier: Final[OrderedDict[str, docutils.nodes.field_body]]
 
+    #: Previous name in the meta-model given as ``:renamed_from:``, if renamed
+    renamed_from: Final[Optional[Identifier]]
+
+    #: Version of the meta-model given as ``:since:`` which introduced the element
+    since: Final[Optional[str]]
+
     # fmt: off
     @require(
         lambda constraints_by_identifier:
@@ -318,6 +324,8 @@ class SummaryRemarksConstraintsDescription(SummaryRemarksDescription):
         summary: docutils.nodes.paragraph,
         remarks: Sequence[docutils.nodes.Element],
         constraints_by_identifier: OrderedDict[str, docutils.nodes.field_body],
+        renamed_from: Optional[Identifier],
+        since: Optional[str],
         parsed: parse.Description,
     ) -> None:
         """Initialize with the given values."""
@@ -325,6 +333,8 @@ class SummaryRemarksConstraintsDescription(SummaryRemarksDescription):
             self, summary=summary, remarks=remarks, parsed=parsed
         )
         self.constraints_by_identifier = constraints_by_identifier
+        self.renamed_from = renamed_from
+        self.since = since
 
 
 class DescriptionOfMetaModel(SummaryRemarksConstraintsDescription):
@@ -435,6 +445,10 @@ class Property:
     #: a class.
     specified_for: Final["Class"]
 
+    #: If set, the property has been marked with ``access_controlled`` as
+    #: security-relevant so that the access to it needs to be checked
+    access_controlled: Final[bool]
+
     #: Relation to the property from the parse stage
     parsed: Final[parse.Property]
 
@@ -444,6 +458,7 @@ class Property:
         type_annotation: TypeAnnotationUnion,
         description: Optional[DescriptionOfProperty],
         specified_for: "Class",
+        access_controlled: bool,
         parsed: parse.Property,
     ) -> None:
         """Initialize with the given values."""
@@ -451,6 +466,7 @@ class Property:
         self.type_annotation = type_annotation
         self.description = description
         self.specified_for = specified_for
+        self.access_controlled = access_controlled
         self.parsed = parsed
 
     def __repr__(self) -> str:
@@ -143,3 +143,13 @@ class ReferenceToConstant(
         docutils.nodes.TextElement.__init__(
             self, rawsource, text, *children, **attributes
         )
+
+
+class Example(docutils.nodes.General, docutils.nodes.Element):  # type: ignore
+    """
+    Represent an example in the documentation.
+
+    The example is given by the directive ``.. example::``. Its content is parsed
+    as nested reStructuredText, usually a short explanation followed by
+    a literal block with the code.
+    """
@@ -0,0 +1,167 @@
+"""
+Understand the shared snippets of implementation-specific verification functions.
+
+A shared snippet is written in the same restricted subset of Python as the bodies
+of the transpilable verification functions in the meta-model. Unlike
+the per-language snippets, it needs to be written only once and is transpiled to
+every target which does not provide its own snippet.
+"""
+import ast
+from typing import Optional, Tuple, List, Final
+
+from icontract import ensure
+
+from aas_core_codegen import parse, specific_implementations
+from aas_core_codegen.common import Error, LinenoColumner
+from aas_core_codegen.intermediate import _types
+
+
+def implementation_key(
+    verification: _types.ImplementationSpecificVerification,
+) -> specific_implementations.ImplementationKey:
+    """Generate the key of the shared snippet for the ``verification``."""
+    return specific_implementations.ImplementationKey(
+        f"Verification/{verification.name}.py"
+    )
+
+
+class UnderstoodSnippet:
+    """Represent a shared snippet understood as a transpilable verification."""
+
+    #: Verification function with the body taken from the snippet
+    verification: Final[_types.TranspilableVerification]
+
+    #: Key of the snippet
+    key: Final[specific_implementations.ImplementationKey]
+
+    #: Locate the nodes of the snippet body for the error messages
+    lineno_columner: Final[LinenoColumner]
+
+    def __init__(
+        self,
+        verification: _types.TranspilableVerification,
+        key: specific_implementations.ImplementationKey,
+        lineno_columner: LinenoColumner,
+    ) -> None:
+        """Initialize with the given values."""
+        self.verification = verification
+        self.key = key
+        self.lineno_columner = lineno_columner
+
+    def localize(self, error: Error) -> Error:
+        """
+        Render the errors underlying ``error`` with the positions in the snippet.
+
+        The ``error`` itself is expected to refer to the verification function in
+        the meta-model, while the underlying errors refer to the snippet body.
+        """
+        if error.underlying is None:
+            return error
+
+        return Error(
+            error.node,
+            f"{error.message} (in the shared snippet {self.key})",
+            [
+                Error(None, self.lineno_columner.error_message(underlying))
+                for underlying in error.underlying
+            ],
+        )
+
+
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def understand(
+    verification: _types.ImplementationSpecificVerification,
+    code: str,
+) -> Tuple[Optional[UnderstoodSnippet], Optional[Error]]:
+    """
+    Understand the shared snippet ``code`` as the body of the ``verification``.
+
+    The snippet needs to define a single function with the same name and
+    the same arguments as the ``verification`` in the meta-model. We reuse
+    the signature and the description from the meta-model.
+
+    The nodes of the snippet do not belong to the meta-model, so we report all
+    the errors at the ``verification`` and pin-point the snippet in the messages.
+    """
+    key = implementation_key(verification)
+
+    atok, parse_exception = parse.source_to_atok(source=code)
+    if parse_exception is not None:
+        return None, Error(
+            verification.parsed.node,
+            f"Failed to parse the shared snippet {key}: {parse_exception}",
+        )
+
+    assert atok is not None
+    lineno_columner = LinenoColumner(atok=atok)
+
+    function_defs = [
+        node
+        for node in atok.tree.body  # type: ignore
+        if isinstance(node, ast.FunctionDef) and node.name == verification.name
+    ]  # type: List[ast.FunctionDef]
+
+    if len(function_defs) != 1:
+        return None, Error(
+            verification.parsed.node,
+            f"Expected exactly one function {verification.name!r} "
+            f"in the shared snippet {key}, but got {len(function_defs)}",
+        )
+
+    function_def = function_defs[0]
+
+    expected_arg_names = [arg.name for arg in verification.arguments]
+    arg_names = [arg.arg for arg in function_def.args.args]
+    if arg_names != expected_arg_names:
+        return None, Error(
+            verification.parsed.node,
+            f"Expected the arguments of the function {verification.name!r} "
+            f"in the shared snippet {key} to be {expected_arg_names!r} "
+            f"as in the meta-model, but got {arg_names!r}",
+        )
+
+    body = function_def.body
+    if (
+        len(body) > 0
+        and isinstance(body[0], ast.Expr)
+        and isinstance(body[0].value, ast.Constant)
+        and isinstance(body[0].value.value, str)
+    ):
+        body = body[1:]
+
+    understood_body, errors = parse.understand_body(body=body)
+    if errors is not None:
+        return None, Error(
+            verification.parsed.node,
+            f"Failed to understand the shared snippet {key}:\n"
+            + "\n".join(lineno_columner.error_message(error) for error in errors),
+        )
+
+    assert understood_body is not None
+
+    parsed = verification.parsed
+
+    return (
+        UnderstoodSnippet(
+            verification=_types.TranspilableVerification(
+                name=verification.name,
+                arguments=verification.arguments,
+                returns=verification.returns,
+                description=verification.description,
+                contracts=verification.contracts,
+                parsed=parse.UnderstoodMethod(
+                    name=parsed.name,
+                    verification=parsed.verification,
+                    arguments=parsed.arguments,
+                    returns=parsed.returns,
+                    description=parsed.description,
+                    contracts=parsed.contracts,
+                    body=understood_body,
+                    node=parsed.node,
+                ),
+            ),
+            key=key,
+            lineno_columner=lineno_columner,
+        ),
+        None,
+    )
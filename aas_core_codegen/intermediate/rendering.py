@@ -49,9 +49,15 @@ class DocutilsElementTransformer(Generic[T], DBC):
         elif isinstance(element, doc.ReferenceToConstant):
             return self.transform_reference_to_constant_in_doc(element)
 
+        elif isinstance(element, doc.Example):
+            return self.transform_example(element)
+
         elif isinstance(element, docutils.nodes.literal):
             return self.transform_literal(element)
 
+        elif isinstance(element, docutils.nodes.literal_block):
+            return self.transform_literal_block(element)
+
         elif isinstance(element, docutils.nodes.paragraph):
             return self.transform_paragraph(element)
 
@@ -141,6 +147,22 @@ class DocutilsElementTransformer(Generic[T], DBC):
         """Transform a code literal into something."""
         raise NotImplementedError()
 
+    @abc.abstractmethod
+    @ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+    def transform_literal_block(
+        self, element: docutils.nodes.literal_block
+    ) -> Tuple[Optional[T], Optional[List[str]]]:
+        """Transform a block of code into something."""
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    @ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+    def transform_example(
+        self, element: doc.Example
+    ) -> Tuple[Optional[T], Optional[List[str]]]:
+        """Transform an example into something."""
+        raise NotImplementedError()
+
     @abc.abstractmethod
     @ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
     def transform_paragraph(
@@ -2,14 +2,21 @@
 
 import argparse
 import enum
+import os
 import pathlib
+import random
+import subprocess
 import sys
-from typing import TextIO
+import tempfile
+from typing import TextIO, Optional, List, AbstractSet, Sequence
 
 import aas_core_codegen
 from aas_core_codegen import parse, run, specific_implementations, intermediate
 from aas_core_codegen.common import LinenoColumner, assert_never
 import aas_core_codegen.csharp.main as csharp_main
+import aas_core_codegen.csharp.compilation as csharp_compilation
+import aas_core_codegen.constraints_report.main as constraints_report_main
+import aas_core_codegen.doc_model.main as doc_model_main
 import aas_core_codegen.jsonschema.main as jsonschema_main
 import aas_core_codegen.rdf_shacl.main as rdf_shacl_main
 import aas_core_codegen.xsd.main as xsd_main
@@ -21,6 +28,8 @@ class Target(enum.Enum):
     """List available target implementations."""
 
     CSHARP = "csharp"
+    CONSTRAINTS_REPORT = "constraints_report"
+    DOC_MODEL = "doc_model"
     JSONSCHEMA = "jsonschema"
     RDF_SHACL = "rdf_shacl"
     XSD = "xsd"
@@ -35,12 +44,128 @@ class Parameters:
         target: Target,
         snippets_dir: pathlib.Path,
         output_dir: pathlib.Path,
+        smoke_compile: bool = False,
+        log_format: run.LogFormat = run.LogFormat.HUMAN,
+        profile: bool = False,
+        assert_deterministic: bool = False,
+        optimize_for_size: bool = False,
+        lenient_enum_parsing: bool = False,
+        extras: AbstractSet[run.Extra] = frozenset(),
     ) -> None:
         """Initialize with the given values."""
         self.model_path = model_path
         self.target = target
         self.snippets_dir = snippets_dir
         self.output_dir = output_dir
+        self.smoke_compile = smoke_compile
+        self.log_format = log_format
+        self.profile = profile
+        self.assert_deterministic = assert_deterministic
+        self.optimize_for_size = optimize_for_size
+        self.lenient_enum_parsing = lenient_enum_parsing
+        self.extras = extras
+
+
+def _compare_outputs(
+    expected_dir: pathlib.Path,
+    expected_written_paths: Sequence[pathlib.Path],
+    got_dir: pathlib.Path,
+) -> Optional[List[str]]:
+    """
+    Compare byte-wise the files generated to ``expected_dir`` and ``got_dir``.
+
+    The ``expected_dir`` might contain files from before the generation, so we
+    consider only the ``expected_written_paths`` there. The ``got_dir`` is expected
+    to contain only the generated files.
+    """
+    expected_pths = sorted(
+        set(pth.relative_to(expected_dir) for pth in expected_written_paths)
+    )
+    got_pths = sorted(
+        pth.relative_to(got_dir) for pth in got_dir.glob("**/*") if pth.is_file()
+    )
+
+    errors = []  # type: List[str]
+
+    got_pth_set = set(got_pths)
+    for rel_pth in expected_pths:
+        if rel_pth not in got_pth_set:
+            errors.append(f"The file {rel_pth} has not been generated the second time")
+        elif (expected_dir / rel_pth).read_bytes() != (got_dir / rel_pth).read_bytes():
+            errors.append(f"The file {rel_pth} differs between the two generations")
+
+    expected_pth_set = set(expected_pths)
+    for rel_pth in got_pths:
+        if rel_pth not in expected_pth_set:
+            errors.append(f"The file {rel_pth} has been generated only the second time")
+
+    if len(errors) > 0:
+        return errors
+
+    return None
+
+
+def _assert_deterministic(
+    params: Parameters, written_paths: Sequence[pathlib.Path]
+) -> Optional[List[str]]:
+    """
+    Generate the code once more and compare it against the first generation.
+
+    The ``written_paths`` are the files written in the first generation.
+
+    We generate in a separate process with a different hash seed so that we also
+    catch the iterations over sets and other hash-dependent orders.
+    """
+    hash_seed = random.randint(1, 4294967295)
+
+    with tempfile.TemporaryDirectory() as tmp_dir:
+        completed = subprocess.run(
+            [
+                sys.executable,
+                "-m",
+                "aas_core_codegen",
+                "--model_path",
+                str(params.model_path),
+                "--snippets_dir",
+                str(params.snippets_dir),
+                "--output_dir",
+                tmp_dir,
+                "--target",
+                params.target.value,
+            ]
+            + (["--optimize_for_size"] if params.optimize_for_size else [])
+            + (["--lenient_enum_parsing"] if params.lenient_enum_parsing else [])
+            + [
+                arg
+                for extra in sorted(params.extras, key=lambda extra: extra.value)
+                for arg in ["--extra", extra.value]
+            ],
+            stdout=subprocess.PIPE,
+            stderr=subprocess.PIPE,
+            encoding="utf-8",
+            env=dict(os.environ, PYTHONHASHSEED=str(hash_seed)),
+            check=False,
+        )
+
+        if completed.returncode != 0:
+            return [
+                f"The second generation with PYTHONHASHSEED={hash_seed} failed:\n"
+                f"{completed.stderr.strip()}"
+            ]
+
+        errors = _compare_outputs(
+            expected_dir=params.output_dir,
+            expected_written_paths=written_paths,
+            got_dir=pathlib.Path(tmp_dir),
+        )
+
+    if errors is not None:
+        return [
+            f"{error} (the second generation used PYTHONHASHSEED={hash_seed})"
+            for error in errors
+        ]
+
+    return None
 
 
 # noinspection SpellCheckingInspection
@@ -74,7 +199,9 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
 
     # BEFORE-RELEASE (mristin, 2021-12-13): test the happy path
     if not params.output_dir.exists():
-        params.output_dir.mkdir(parents=True, exist_ok=True)
+        run.extended_length_path(params.output_dir).mkdir(
+            parents=True, exist_ok=True
+        )
     else:
         # BEFORE-RELEASE (mristin, 2021-12-13): test this failure case
         if not params.output_dir.is_dir():
@@ -86,11 +213,14 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
 
     # endregion
 
+    logger = run.Logger(stream=stdout, log_format=params.log_format)
+
     # region Parse
 
-    spec_impls, spec_impls_errors = specific_implementations.read_from_directory(
-        snippets_dir=params.snippets_dir
-    )
+    with logger.phase("Read snippets"):
+        spec_impls, spec_impls_errors = specific_implementations.read_from_directory(
+            snippets_dir=params.snippets_dir
+        )
 
     if spec_impls_errors:
         run.write_error_report(
@@ -103,11 +233,12 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
 
     assert spec_impls is not None
 
-    text = params.model_path.read_text(encoding="utf-8")
+    text = params.model_path.read_text(encoding="utf-8-sig")
 
     # BEFORE-RELEASE (mristin, 2021-12-13):
     #  test all the following individual failure cases
-    atok, parse_exception = parse.source_to_atok(source=text)
+    with logger.phase("Parse"):
+        atok, parse_exception = parse.source_to_atok(source=text)
     if parse_exception:
         if isinstance(parse_exception, SyntaxError):
             stderr.write(
@@ -134,7 +265,8 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
 
     lineno_columner = LinenoColumner(atok=atok)
 
-    parsed_symbol_table, error = parse.atok_to_symbol_table(atok=atok)
+    with logger.phase("Understand"):
+        parsed_symbol_table, error = parse.atok_to_symbol_table(atok=atok)
     if error is not None:
         run.write_error_report(
             message=f"Failed to construct the symbol table from {params.model_path}",
@@ -146,10 +278,11 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
 
     assert parsed_symbol_table is not None
 
-    ir_symbol_table, error = intermediate.translate(
-        parsed_symbol_table=parsed_symbol_table,
-        atok=atok,
-    )
+    with logger.phase("Translate"):
+        ir_symbol_table, error = intermediate.translate(
+            parsed_symbol_table=parsed_symbol_table,
+            atok=atok,
+        )
     if error is not None:
         run.write_error_report(
             message=f"Failed to translate the parsed symbol table "
@@ -173,28 +306,114 @@ def execute(params: Parameters, stdout: TextIO, stderr: TextIO) -> int:
         spec_impls=spec_impls,
         lineno_columner=lineno_columner,
         output_dir=params.output_dir,
+        logger=logger,
+        optimize_for_size=params.optimize_for_size,
+        lenient_enum_parsing=params.lenient_enum_parsing,
+        extras=params.extras,
     )
 
-    if params.target is Target.CSHARP:
-        return csharp_main.execute(context=run_context, stdout=stdout, stderr=stderr)
+    if params.optimize_for_size and params.target is not Target.CSHARP:
+        logger.info(
+            f"There is nothing to optimize for size "
+            f"for the target {params.target.value!r}."
+        )
 
-    elif params.target is Target.JSONSCHEMA:
-        return jsonschema_main.execute(
-            context=run_context, stdout=stdout, stderr=stderr
+    if params.lenient_enum_parsing and params.target is not Target.CSHARP:
+        logger.info(
+            f"There is no lenient parsing of enumerations "
+            f"for the target {params.target.value!r}."
         )
 
-    elif params.target is Target.RDF_SHACL:
-        return rdf_shacl_main.execute(context=run_context, stdout=stdout, stderr=stderr)
+    if len(params.extras) > 0 and params.target is not Target.CSHARP:
+        logger.info(
+            f"There are no extra outputs to generate "
+            f"for the target {params.target.value!r}."
+        )
 
-    elif params.target is Target.XSD:
-        return xsd_main.execute(context=run_context, stdout=stdout, stderr=stderr)
+    return_code = None  # type: Optional[int]
 
-    else:
-        assert_never(params.target)
+    with logger.phase(f"Generate {params.target.value}"):
+        if params.target is Target.CSHARP:
+            return_code = csharp_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        elif params.target is Target.CONSTRAINTS_REPORT:
+            return_code = constraints_report_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        elif params.target is Target.DOC_MODEL:
+            return_code = doc_model_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        elif params.target is Target.JSONSCHEMA:
+            return_code = jsonschema_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        elif params.target is Target.RDF_SHACL:
+            return_code = rdf_shacl_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        elif params.target is Target.XSD:
+            return_code = xsd_main.execute(
+                context=run_context, stdout=stdout, stderr=stderr
+            )
+
+        else:
+            assert_never(params.target)
+
+    assert return_code is not None
+
+    if return_code != 0:
+        return return_code
+
+    # endregion
+
+    # region Smoke compilation
+
+    if params.smoke_compile:
+        if params.target is Target.CSHARP:
+            with logger.phase("Smoke-compile"):
+                return_code = csharp_compilation.smoke_compile(
+                    output_dir=params.output_dir, logger=logger, stderr=stderr
+                )
+
+            if return_code != 0:
+                return return_code
+        else:
+            logger.info(
+                f"There is nothing to smoke-compile "
+                f"for the target {params.target.value!r}."
+            )
+
+    # endregion
+
+    # region Assert determinism
+
+    if params.assert_deterministic:
+        with logger.phase("Assert determinism"):
+            determinism_errors = _assert_deterministic(
+                params=params, written_paths=run_context.written_paths
+            )
+
+        if determinism_errors is not None:
+            run.write_error_report(
+                message=f"The generation of {params.target.value} "
+                f"is not deterministic",
+                errors=determinism_errors,
+                stderr=stderr,
+            )
+            return 1
 
     # endregion
 
-    stdout.write(f"Code generated to: {params.output_dir}\n")
+    if params.profile:
+        logger.write_profile()
+
     return 0
 
 
@@ -221,6 +440,56 @@ def main(prog: str) -> int:
         required=True,
         choices=[literal.value for literal in Target],
     )
+    parser.add_argument(
+        "--smoke_compile",
+        help=(
+            "compile the generated code, if the toolchain is available, "
+            "to catch the errors in the generator early"
+        ),
+        action="store_true",
+    )
+    parser.add_argument(
+        "--log_format",
+        help="format of the progress messages",
+        default=run.LogFormat.HUMAN.value,
+        choices=[literal.value for literal in run.LogFormat],
+    )
+    parser.add_argument(
+        "--profile",
+        help="report the time spent in the individual phases of the generation",
+        action="store_true",
+    )
+    parser.add_argument(
+        "--assert_deterministic",
+        help=(
+            "generate the code once more in a separate process and "
+            "check that the output is byte-identical"
+        ),
+        action="store_true",
+    )
+    parser.add_argument(
+        "--optimize_for_size",
+        help="generate the code without the documentation comments",
+        action="store_true",
+    )
+    parser.add_argument(
+        "--lenient_enum_parsing",
+        help=(
+            "additionally generate the parsing of enumerations which accepts "
+            "case-insensitive input and the names of the literals"
+        ),
+        action="store_true",
+    )
+    parser.add_argument(
+        "--extra",
+        help=(
+            "additionally generate the given optional output; "
+            "repeat to generate more than one"
+        ),
+        action="append",
+        default=[],
+        choices=[literal.value for literal in run.Extra],
+    )
     parser.add_argument(
         "--version", help="show the current version and exit", action="store_true"
     )
@@ -241,6 +510,13 @@ def main(prog: str) -> int:
         target=target_to_str[args.target],
         snippets_dir=pathlib.Path(args.snippets_dir),
         output_dir=pathlib.Path(args.output_dir),
+        smoke_compile=args.smoke_compile,
+        log_format=run.LogFormat(args.log_format),
+        profile=args.profile,
+        assert_deterministic=args.assert_deterministic,
+        optimize_for_size=args.optimize_for_size,
+        lenient_enum_parsing=args.lenient_enum_parsing,
+        extras={run.Extra(value) for value in args.extra},
     )
 
     return execute(params=params, stdout=sys.stdout, stderr=sys.stderr)
@@ -43,5 +43,6 @@ GENERIC_TYPES = _types.GENERIC_TYPES
 source_to_atok = _translate.source_to_atok
 check_expected_imports = _translate.check_expected_imports
 atok_to_symbol_table = _translate.atok_to_symbol_table
+understand_body = _translate.understand_body
 
 dump = _stringify.dump
@@ -150,6 +150,7 @@ def _stringify_property(that: Property) -> stringify.Entity:
             stringify.Property("name", that.name),
             stringify.Property("type_annotation", _stringify(that.type_annotation)),
             stringify.Property("description", _stringify(that.description)),
+            stringify.Property("access_controlled", that.access_controlled),
             stringify.PropertyEllipsis("node", that.node),
         ],
     )
@@ -1,6 +1,7 @@
 """Translate the abstract syntax tree of the meta-model into parsed structures."""
 import ast
 import collections
+import copy
 import enum
 import io
 import itertools
@@ -123,6 +124,7 @@ class _ExpectedImportsVisitor(ast.NodeVisitor):
             ("ensure", "icontract"),
             ("require", "icontract"),
             ("abstract", "aas_core_meta.marker"),
+            ("access_controlled", "aas_core_meta.marker"),
             ("constant_set", "aas_core_meta.marker"),
             ("implementation_specific", "aas_core_meta.marker"),
             ("reference_in_the_book", "aas_core_meta.marker"),
@@ -204,6 +206,42 @@ def _type_annotation(
 
         return AtomicTypeAnnotation(identifier=Identifier(node.value), node=node), None
 
+    elif isinstance(node, ast.BinOp) and isinstance(node.op, ast.BitOr):
+        # We understand the union with ``None`` (PEP 604) as an ``Optional[...]``.
+        # Other unions are not supported in the meta-model.
+        operands = [node.left, node.right]
+        not_none = [
+            operand
+            for operand in operands
+            if not (isinstance(operand, ast.Constant) and operand.value is None)
+        ]
+
+        if len(not_none) != 1:
+            return (
+                None,
+                Error(
+                    node,
+                    f"Expected a union of a type and None "
+                    f"to define an optional type annotation, "
+                    f"but got: {atok.get_text(node)}",
+                ),
+            )
+
+        value_annotation, error = _type_annotation(node=not_none[0], atok=atok)
+        if error is not None:
+            return None, error
+
+        assert value_annotation is not None
+
+        return (
+            SubscriptedTypeAnnotation(
+                identifier=Identifier("Optional"),
+                subscripts=[value_annotation],
+                node=node,
+            ),
+            None,
+        )
+
     elif isinstance(node, ast.Subscript):
         if not isinstance(node.value, ast.Name):
             return (
@@ -287,7 +325,7 @@ def _type_annotation(
 
                 subscripts.append(subscript_annotation)
 
-        elif isinstance(index_node, (ast.Name, ast.Subscript, ast.Constant)):
+        elif isinstance(index_node, (ast.Name, ast.Subscript, ast.Constant, ast.BinOp)):
             subscript_annotation, error = _type_annotation(node=index_node, atok=atok)
             if error is not None:
                 return None, error
@@ -957,7 +995,10 @@ def _ann_assign_to_constant(
 # noinspection PyTypeChecker
 @ensure(lambda result: (result[0] is None) ^ (result[1] is None))
 def _ann_assign_to_property(
-    node: ast.AnnAssign, description: Optional[Description], atok: asttokens.ASTTokens
+    node: ast.AnnAssign,
+    description: Optional[Description],
+    access_controlled: bool,
+    atok: asttokens.ASTTokens,
 ) -> Tuple[Optional[Property], Optional[Error]]:
     if not isinstance(node.target, ast.Name):
         return (
@@ -1001,6 +1042,7 @@ def _ann_assign_to_property(
             name=Identifier(node.target.id),
             type_annotation=type_annotation,
             description=description,
+            access_controlled=access_controlled,
             node=node,
         ),
         None,
@@ -1326,6 +1368,41 @@ def _parse_snapshot(
     )
 
 
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+def understand_body(
+    body: Sequence[ast.stmt],
+) -> Tuple[Optional[List[tree.Node]], Optional[List[Error]]]:
+    """
+    Understand the statements of a function ``body`` with our rules.
+
+    The docstring is expected to be already removed from the ``body``.
+    """
+    errors = []  # type: List[Error]
+
+    understood_body = []  # type: List[tree.Node]
+
+    for body_child in body:
+        # NOTE (mristin, 2021-12-27):
+        # We deliberately ignore ``pass`` as it makes no sense in our
+        # context of multiple programming languages.
+        if isinstance(body_child, ast.Pass):
+            continue
+
+        understood_node, understanding_error = _rules.ast_node_to_our_node(body_child)
+
+        if understanding_error is not None:
+            errors.append(understanding_error)
+            continue
+
+        assert understood_node is not None
+        understood_body.append(understood_node)
+
+    if len(errors) > 0:
+        return None, errors
+
+    return understood_body, None
+
+
 # BEFORE-RELEASE (mristin, 2021-12-13):
 #  include severity levels for contracts in the metamodel and
 #  consider them in the imports
@@ -1688,35 +1765,17 @@ def _function_def_to_method(
                 None,
             )
         else:
-            understanding_errors = []  # type: List[Error]
-
-            understood_body = []  # type: List[tree.Node]
+            understood_body, understanding_errors = understand_body(body=body)
 
-            for body_child in body:
-                # NOTE (mristin, 2021-12-27):
-                # We deliberately ignore ``pass`` as it makes no sense in our
-                # context of multiple programming languages.
-                if isinstance(body_child, ast.Pass):
-                    continue
-
-                understood_node, understanding_error = _rules.ast_node_to_our_node(
-                    body_child
-                )
-
-                if understanding_error is not None:
-                    understanding_errors.append(understanding_error)
-                    continue
-
-                assert understood_node is not None
-                understood_body.append(understood_node)
-
-            if len(understanding_errors) > 0:
+            if understanding_errors is not None:
                 return None, Error(
                     node,
                     f"Failed to understand the body of the function {name!r}",
                     understanding_errors,
                 )
 
+            assert understood_body is not None
+
             return (
                 UnderstoodMethod(
                     name=Identifier(name),
@@ -1991,11 +2050,71 @@ def _class_decorator_to_invariant(
     )
 
 
+class _AccessControlled:
+    """Represent the marker of the access-controlled properties of a class."""
+
+    def __init__(self, property_names: Sequence[Identifier], node: ast.Call) -> None:
+        """Initialize with the given values."""
+        self.property_names = property_names
+        self.node = node
+
+
+# fmt: off
+# noinspection PyTypeChecker
+@require(
+    lambda decorator:
+    isinstance(decorator.func, ast.Name)
+    and isinstance(decorator.func.ctx, ast.Load)
+    and decorator.func.id == 'access_controlled'
+)
+@ensure(lambda result: (result[0] is not None) ^ (result[1] is not None))
+# fmt: on
+def _class_decorator_to_access_controlled(
+    decorator: ast.Call, atok: asttokens.ASTTokens
+) -> Tuple[Optional[_AccessControlled], Optional[Error]]:
+    """Parse the decorator node as a marker of access-controlled properties."""
+    if len(decorator.keywords) > 0:
+        return (
+            None,
+            Error(
+                decorator,
+                "Expected only positional arguments to ``access_controlled``, "
+                "but got keyword arguments",
+            ),
+        )
+
+    if len(decorator.args) == 0:
+        return (
+            None,
+            Error(
+                decorator,
+                "Expected at least one property name in ``access_controlled``",
+            ),
+        )
+
+    property_names = []  # type: List[Identifier]
+    for arg in decorator.args:
+        if not isinstance(arg, ast.Constant) or not isinstance(arg.value, str):
+            return (
+                None,
+                Error(
+                    arg,
+                    f"Expected the property names in ``access_controlled`` to be "
+                    f"string literals, but got: {atok.get_text(arg)}",
+                ),
+            )
+
+        property_names.append(Identifier(arg.value))
+
+    return _AccessControlled(property_names=property_names, node=decorator), None
+
+
 _ClassDecoratorUnion = Union[
     _ClassMarker,
     Serialization,
     ReferenceInTheBook,
     Invariant,
+    _AccessControlled,
 ]
 
 
@@ -2037,6 +2156,10 @@ def _parse_class_decorator(
             )
         elif decorator.func.id == "invariant":
             return _class_decorator_to_invariant(decorator=decorator, atok=atok)
+        elif decorator.func.id == "access_controlled":
+            return _class_decorator_to_access_controlled(
+                decorator=decorator, atok=atok
+            )
         else:
             return None, Error(
                 decorator,
@@ -2277,6 +2400,9 @@ def _classdef_to_our_type(
     reference_in_the_book = None  # type: Optional[ReferenceInTheBook]
     serialization = None  # type: Optional[Serialization]
 
+    # Map the names of the access-controlled properties to the marker nodes
+    access_controlled_nodes = dict()  # type: MutableMapping[Identifier, ast.Call]
+
     for decorator_node in node.decorator_list:
         decorator, error = _parse_class_decorator(decorator=decorator_node, atok=atok)
         if error is not None:
@@ -2323,6 +2449,20 @@ def _classdef_to_our_type(
         elif isinstance(decorator, Invariant):
             invariants.append(decorator)
 
+        elif isinstance(decorator, _AccessControlled):
+            for property_name in decorator.property_names:
+                if property_name in access_controlled_nodes:
+                    underlying_errors.append(
+                        Error(
+                            decorator.node,
+                            f"Unexpected double access_controlled marker "
+                            f"for the property {property_name!r}",
+                        )
+                    )
+                    continue
+
+                access_controlled_nodes[property_name] = decorator.node
+
         else:
             assert_never(decorator)
 
@@ -2400,7 +2540,13 @@ def _classdef_to_our_type(
                 cursor += 1
 
             prop, error = _ann_assign_to_property(
-                node=expr, description=description_of_property, atok=atok
+                node=expr,
+                description=description_of_property,
+                access_controlled=(
+                    isinstance(expr.target, ast.Name)
+                    and expr.target.id in access_controlled_nodes
+                ),
+                atok=atok,
             )
             cursor += 1
 
@@ -2447,6 +2593,19 @@ def _classdef_to_our_type(
 
         assert old_cursor < cursor, f"Loop invariant: {old_cursor=}, {cursor=}"
 
+    property_names = set(prop.name for prop in properties)
+    for property_name, marker_node in access_controlled_nodes.items():
+        if property_name not in property_names:
+            return (
+                None,
+                Error(
+                    marker_node,
+                    f"The property {property_name!r} marked as access-controlled "
+                    f"is not defined in the class {node.name!r}; only the properties "
+                    f"defined in the class itself can be marked",
+                ),
+            )
+
     if is_abstract:
         factory_for_class = (
             AbstractClass
@@ -2987,6 +3146,151 @@ def _verify_symbol_table(
     return SymbolTable(symbol_table), None
 
 
+# The ``type`` statement (PEP 695) is only available in the ``ast`` module
+# from Python 3.12 on.
+_AST_TYPE_ALIAS = getattr(ast, "TypeAlias", None)
+
+
+def _is_type_alias(node: ast.AST) -> bool:
+    """Check whether ``node`` is a ``type`` statement."""
+    return _AST_TYPE_ALIAS is not None and isinstance(node, _AST_TYPE_ALIAS)
+
+
+def _collect_type_aliases(
+    atok: asttokens.ASTTokens,
+) -> Tuple[Optional[Mapping[Identifier, ast.expr]], Optional[List[Error]]]:
+    """
+    Map the names of the type aliases in the module to their resolved values.
+
+    The aliases can refer to each other, but not in a cycle.
+    """
+    values_by_name = dict()  # type: MutableMapping[Identifier, ast.expr]
+    errors = []  # type: List[Error]
+
+    for node in atok.tree.body:  # type: ignore
+        if not _is_type_alias(node):
+            continue
+
+        if len(node.type_params) > 0:  # type: ignore
+            errors.append(
+                Error(
+                    node,
+                    f"Generic type aliases are not supported in the meta-model, "
+                    f"but got: {atok.get_text(node)}",
+                )
+            )
+            continue
+
+        name = Identifier(node.name.id)  # type: ignore
+        if name in values_by_name:
+            errors.append(Error(node, f"The type alias {name!r} is defined twice"))
+            continue
+
+        values_by_name[name] = node.value  # type: ignore
+
+    if len(errors) > 0:
+        return None, errors
+
+    resolved = dict()  # type: MutableMapping[Identifier, ast.expr]
+
+    def resolve(name: Identifier, path: List[Identifier]) -> Optional[Error]:
+        """Resolve the alias ``name`` while following the ``path`` of aliases."""
+        if name in resolved:
+            return None
+
+        if name in path:
+            return Error(
+                values_by_name[name],
+                f"The type aliases are defined in a cycle: "
+                f"{' -> '.join(path + [name])}",
+            )
+
+        for referenced in _names_in_annotation(values_by_name[name]):
+            if referenced in values_by_name:
+                error = resolve(referenced, path + [name])
+                if error is not None:
+                    return error
+
+        resolved[name] = _TypeAliasSubstituter(resolved).substitute(
+            values_by_name[name]
+        )
+        return None
+
+    for name in values_by_name:
+        error = resolve(name, [])
+        if error is not None:
+            return None, [error]
+
+    return resolved, None
+
+
+def _names_in_annotation(node: ast.expr) -> List[Identifier]:
+    """List the names referenced in the type annotation ``node``."""
+    result = []  # type: List[Identifier]
+    for descendant in ast.walk(node):
+        if isinstance(descendant, ast.Name):
+            result.append(Identifier(descendant.id))
+        elif isinstance(descendant, ast.Constant) and isinstance(
+            descendant.value, str
+        ):
+            result.append(Identifier(descendant.value))
+
+    return result
+
+
+class _TypeAliasSubstituter(ast.NodeTransformer):
+    """Replace the type aliases in the type annotations with their values."""
+
+    def __init__(self, values_by_name: Mapping[Identifier, ast.expr]) -> None:
+        """Initialize with the resolved values of the aliases."""
+        self.values_by_name = values_by_name
+
+    def substitute(self, node: ast.expr) -> ast.expr:
+        """Substitute the aliases in the type annotation ``node``."""
+        # We copy the value at every substitution so that the nodes are
+        # not shared between the annotations, and the later transformations
+        # of one annotation do not leak into the others.
+        if isinstance(node, ast.Name) and node.id in self.values_by_name:
+            return copy.deepcopy(self.values_by_name[Identifier(node.id)])
+
+        if (
+            isinstance(node, ast.Constant)
+            and isinstance(node.value, str)
+            and node.value in self.values_by_name
+        ):
+            return copy.deepcopy(self.values_by_name[Identifier(node.value)])
+
+        if isinstance(node, ast.Subscript):
+            node.slice = self.substitute(node.slice)
+        elif isinstance(node, ast.Tuple):
+            node.elts = [self.substitute(elt) for elt in node.elts]
+        elif isinstance(node, ast.BinOp):
+            node.left = self.substitute(node.left)
+            node.right = self.substitute(node.right)
+        elif sys.version_info < (3, 9) and isinstance(node, ast.Index):
+            # Please see the note about the deprecation of ``ast.Index`` in
+            # :py:func:`_type_annotation`.
+            node.value = self.substitute(node.value)  # type: ignore
+
+        return node
+
+    # pylint: disable=missing-docstring,invalid-name
+
+    def visit_AnnAssign(self, node: ast.AnnAssign) -> Any:
+        node.annotation = self.substitute(node.annotation)
+        return self.generic_visit(node)
+
+    def visit_arg(self, node: ast.arg) -> Any:
+        if node.annotation is not None:
+            node.annotation = self.substitute(node.annotation)
+        return self.generic_visit(node)
+
+    def visit_FunctionDef(self, node: ast.FunctionDef) -> Any:
+        if node.returns is not None:
+            node.returns = self.substitute(node.returns)
+        return self.generic_visit(node)
+
+
 # noinspection PyTypeChecker,PyUnresolvedReferences
 @require(lambda atok: isinstance(atok.tree, ast.Module))
 @ensure(lambda result: (result[0] is None) ^ (result[1] is None))
@@ -3003,6 +3307,19 @@ def _atok_to_symbol_table(
     verification_functions = []  # type: List[FunctionUnion]
     constants = []  # type: List[ConstantUnion]
 
+    # region Resolve type aliases
+
+    type_aliases, type_alias_errors = _collect_type_aliases(atok=atok)
+    if type_alias_errors is not None:
+        return None, Error(None, "Failed to parse the meta-model", type_alias_errors)
+
+    assert type_aliases is not None
+
+    if len(type_aliases) > 0:
+        _TypeAliasSubstituter(type_aliases).visit(atok.tree)
+
+    # endregion
+
     # region Parse
 
     for node in atok.tree.body:
@@ -3017,6 +3334,10 @@ def _atok_to_symbol_table(
         if isinstance(node, ast.Assert):
             continue
 
+        # The type aliases have been already substituted in the type annotations.
+        if _is_type_alias(node):
+            continue
+
         # noinspection PyUnusedLocal
         matched = False
 
@@ -263,12 +263,14 @@ class Property:
         name: Identifier,
         type_annotation: TypeAnnotation,
         description: Optional[Description],
+        access_controlled: bool,
         node: ast.AnnAssign,
     ) -> None:
         """Initialize with the given values."""
         self.name = name
         self.type_annotation = type_annotation
         self.description = description
+        self.access_controlled = access_controlled
         self.node = node
 
 
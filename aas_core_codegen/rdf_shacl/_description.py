@@ -174,6 +174,42 @@ class Renderer(intermediate_rendering.DocutilsElementTransformer[List["TokenUnio
     ) -> Tuple[Optional[List["TokenUnion"]], Optional[List[str]]]:
         return [TokenText(element.astext())], None
 
+    def transform_literal_block(
+        self, element: docutils.nodes.literal_block
+    ) -> Tuple[Optional[List["TokenUnion"]], Optional[List[str]]]:
+        tokens = []  # type: List["TokenUnion"]
+        for line in element.astext().splitlines():
+            tokens.append(TokenText(line))
+            tokens.append(TokenLineBreak())
+
+        tokens.append(TokenParagraphBreak())
+
+        return tokens, None
+
+    def transform_example(
+        self, element: intermediate_doc.Example
+    ) -> Tuple[Optional[List["TokenUnion"]], Optional[List[str]]]:
+        tokens = []  # type: List["TokenUnion"]
+        errors = []  # type: List[str]
+
+        for child in element.children:
+            child_tokens, child_errors = self.transform(child)
+            if child_errors is not None:
+                errors.extend(child_errors)
+            else:
+                assert child_tokens is not None
+                tokens.extend(child_tokens)
+
+        if len(errors) > 0:
+            return None, errors
+
+        tokens = (
+            cast(List["TokenUnion"], [TokenText("Example:"), TokenLineBreak()])
+            + tokens
+        )
+
+        return tokens, None
+
     def transform_paragraph(
         self, element: docutils.nodes.paragraph
     ) -> Tuple[Optional[List["TokenUnion"]], Optional[List[str]]]:
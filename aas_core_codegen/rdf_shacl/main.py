@@ -77,7 +77,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "rdf-ontology.ttl"
     try:
-        pth.write_text(rdf_code, encoding="utf-8")
+        context.write_text(path=pth, text=rdf_code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the RDF ontology to {pth}",
@@ -110,7 +110,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "shacl-schema.ttl"
     try:
-        pth.write_text(shacl_code, encoding="utf-8")
+        context.write_text(path=pth, text=shacl_code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the SHACL schema to {pth}",
@@ -121,5 +121,8 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     # endregion
 
-    stdout.write(f"Code generated to: {context.output_dir}\n")
+    context.logger.info(
+        f"Code generated to: {context.output_dir}",
+        output_dir=str(context.output_dir),
+    )
     return 0
@@ -1,12 +1,123 @@
 """Encapsulate the entry point to different generators."""
+import collections
+import contextlib
+import enum
+import json
 import pathlib
+import sys
 import textwrap
-from typing import Sequence, TextIO
+import time
+from typing import (
+    Sequence,
+    TextIO,
+    Any,
+    List,
+    Tuple,
+    Iterator,
+    MutableMapping,
+    AbstractSet,
+)
 
 from icontract import require
 
 from aas_core_codegen import specific_implementations, intermediate
-from aas_core_codegen.common import LinenoColumner
+from aas_core_codegen.common import LinenoColumner, assert_never
+
+
+class LogFormat(enum.Enum):
+    """List the formats of the log messages."""
+
+    HUMAN = "human"
+    JSON = "json"
+
+
+class Extra(enum.Enum):
+    """List the optional outputs which are generated only on request."""
+
+    JSON_LINES = "json_lines"
+    FUZZING = "fuzzing"
+    DIGESTION = "digestion"
+    CANONICALIZATION = "canonicalization"
+    LANGUAGE_TAGS = "language_tags"
+    IRI_VALIDATION = "iri_validation"
+    SIGNING = "signing"
+    REDACTION = "redaction"
+    ACCESS_CONTROL = "access_control"
+    INSTRUMENTATION = "instrumentation"
+    STATISTICS = "statistics"
+    FACTORIES = "factories"
+    IDE_SNIPPETS = "ide_snippets"
+    PUBLIC_API = "public_api"
+
+
+class Logger:
+    """Log the progress of the generation and measure the time of its phases."""
+
+    def __init__(self, stream: TextIO, log_format: LogFormat = LogFormat.HUMAN) -> None:
+        """Initialize with the given values and no phases measured."""
+        self.stream = stream
+        self.log_format = log_format
+
+        #: Names of the measured phases and the seconds spent in them
+        self.timings = []  # type: List[Tuple[str, float]]
+
+    def _write(self, record: MutableMapping[str, Any], human: str) -> None:
+        """Write the ``record`` or its ``human`` rendering to the stream."""
+        if self.log_format is LogFormat.HUMAN:
+            self.stream.write(f"{human}\n")
+        elif self.log_format is LogFormat.JSON:
+            self.stream.write(json.dumps(record) + "\n")
+        else:
+            assert_never(self.log_format)
+
+    def info(self, message: str, **fields: Any) -> None:
+        """
+        Log the ``message``.
+
+        The ``fields`` are only written in the JSON format as the ``message``
+        should already be self-explanatory for humans.
+        """
+        record = collections.OrderedDict(
+            [("level", "info"), ("message", message)]
+        )  # type: MutableMapping[str, Any]
+        record.update(fields)
+
+        self._write(record=record, human=message)
+
+    @contextlib.contextmanager
+    def phase(self, name: str) -> Iterator[None]:
+        """Measure the time spent in the phase ``name``."""
+        start = time.perf_counter()
+        try:
+            yield
+        finally:
+            self.timings.append((name, time.perf_counter() - start))
+
+    def write_profile(self) -> None:
+        """Log the time spent in the phases measured so far."""
+        total = sum(seconds for _, seconds in self.timings)
+
+        width = max((len(name) for name, _ in self.timings), default=0)
+        lines = ["Profile:"] + [
+            f"  {name.ljust(width)}  {seconds:.3f} s" for name, seconds in self.timings
+        ]
+        lines.append(f"  {'Total'.ljust(width)}  {total:.3f} s")
+
+        record = collections.OrderedDict(
+            [
+                ("level", "profile"),
+                (
+                    "phases",
+                    [
+                        collections.OrderedDict([("name", name), ("seconds", seconds)])
+                        for name, seconds in self.timings
+                    ],
+                ),
+                ("total_seconds", total),
+            ]
+        )  # type: MutableMapping[str, Any]
+
+        self._write(record=record, human="\n".join(lines))
 
 
 class Context:
@@ -21,6 +132,10 @@ class Context:
         spec_impls: specific_implementations.SpecificImplementations,
         lineno_columner: LinenoColumner,
         output_dir: pathlib.Path,
+        logger: Logger,
+        optimize_for_size: bool = False,
+        lenient_enum_parsing: bool = False,
+        extras: AbstractSet[Extra] = frozenset(),
     ) -> None:
         """Initialize with the given values."""
         self.model_path = model_path
@@ -28,6 +143,53 @@ class Context:
         self.spec_impls = spec_impls
         self.lineno_columner = lineno_columner
         self.output_dir = output_dir
+        self.logger = logger
+        self.optimize_for_size = optimize_for_size
+        self.lenient_enum_parsing = lenient_enum_parsing
+        self.extras = extras
+
+        #: Paths of the files written in this generation, in the order of writing
+        self.written_paths = []  # type: List[pathlib.Path]
+
+    def write_text(self, path: pathlib.Path, text: str) -> None:
+        """Write ``text`` to ``path`` and record it as written in this generation."""
+        write_text(path=path, text=text)
+        self.written_paths.append(path)
+
+
+def extended_length_path(path: pathlib.Path) -> pathlib.Path:
+    """
+    Make the ``path`` absolute and lift the length limit of the paths on Windows.
+
+    Windows limits the paths to 260 characters unless they are given as absolute
+    paths with the ``\\\\?\\`` prefix. The deep output directories, such as
+    the temporary directories in the CI, easily exceed that limit. On other
+    platforms, we return the ``path`` as-is.
+    """
+    if sys.platform != "win32":
+        return path
+
+    text = str(path.resolve())
+    if text.startswith("\\\\?\\"):
+        return pathlib.Path(text)
+
+    if text.startswith("\\\\"):
+        # The network shares need a special prefix, see
+        # https://learn.microsoft.com/en-us/windows/win32/fileio/naming-a-file
+        return pathlib.Path("\\\\?\\UNC\\" + text[2:])
+
+    return pathlib.Path("\\\\?\\" + text)
+
+
+def write_text(path: pathlib.Path, text: str) -> None:
+    """
+    Write ``text`` to ``path`` encoded in UTF-8 with Unix newlines.
+
+    We avoid :py:meth:`pathlib.Path.write_text` since it translates the newlines to
+    the platform ones so that the generated files would differ on Windows.
+    """
+    with extended_length_path(path).open("wt", encoding="utf-8", newline="\n") as fid:
+        fid.write(text)
 
 
 @require(
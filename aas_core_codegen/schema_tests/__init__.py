@@ -0,0 +1 @@
+"""Validate the examples against the freshly generated schemas."""
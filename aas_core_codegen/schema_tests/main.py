@@ -0,0 +1,323 @@
+"""Validate the examples against the freshly generated schemas."""
+
+import abc
+import argparse
+import contextlib
+import io
+import json
+import pathlib
+import sys
+import tempfile
+import warnings
+from typing import TextIO, List, Optional, MutableMapping, Any, Type
+
+from icontract import DBC
+
+import aas_core_codegen
+import aas_core_codegen.main
+from aas_core_codegen import run
+import aas_core_codegen.schema_tests
+
+assert __doc__ == aas_core_codegen.schema_tests.__doc__
+
+
+class Validator(DBC):
+    """Validate the examples against a schema generated for a target."""
+
+    #: Name of the generated schema file in the output directory
+    schema_file: str
+
+    #: Glob pattern of the example files
+    example_glob: str
+
+    @abc.abstractmethod
+    def load(self, output_dir: pathlib.Path) -> None:
+        """
+        Load the schema generated to ``output_dir``.
+
+        Raise an exception if the validation library is not available.
+        """
+        raise NotImplementedError()
+
+    @abc.abstractmethod
+    def validate(self, example_path: pathlib.Path) -> Optional[str]:
+        """Validate the ``example_path`` and return the error message, if any."""
+        raise NotImplementedError()
+
+
+class JsonSchemaValidator(Validator):
+    """Validate the JSON examples with the ``jsonschema`` package."""
+
+    schema_file = "schema.json"
+    example_glob = "**/*.json"
+
+    def __init__(self) -> None:
+        """Initialize with no schema loaded."""
+        self._schema = None  # type: Optional[Any]
+
+    def load(self, output_dir: pathlib.Path) -> None:
+        """Load the JSON schema generated to ``output_dir``."""
+        with (output_dir / self.schema_file).open("rt", encoding="utf-8") as fid:
+            self._schema = json.load(fid)
+
+    def validate(self, example_path: pathlib.Path) -> Optional[str]:
+        """Validate the JSON ``example_path`` against the loaded schema."""
+        assert self._schema is not None, "Expected the schema to be loaded"
+
+        with warnings.catch_warnings():
+            # See the note in ``tests/our_jsonschema/test_main.py`` why we ignore
+            # the deprecation warnings of the ``jsonschema`` package.
+            warnings.filterwarnings("ignore", category=DeprecationWarning)
+            import jsonschema  # pylint: disable=import-outside-toplevel
+
+        with example_path.open("rt", encoding="utf-8-sig") as fid:
+            instance = json.load(fid)
+
+        try:
+            jsonschema.validate(instance=instance, schema=self._schema)
+        except jsonschema.ValidationError as exception:
+            return exception.message
+
+        return None
+
+
+class XsdValidator(Validator):
+    """Validate the XML examples with the ``xmlschema`` package."""
+
+    schema_file = "schema.xsd"
+    example_glob = "**/*.xml"
+
+    def __init__(self) -> None:
+        """Initialize with no schema loaded."""
+        self._schema = None  # type: Optional[Any]
+
+    def load(self, output_dir: pathlib.Path) -> None:
+        """Load the XSD generated to ``output_dir``."""
+        import xmlschema  # pylint: disable=import-outside-toplevel
+
+        self._schema = xmlschema.XMLSchema(str(output_dir / self.schema_file))
+
+    def validate(self, example_path: pathlib.Path) -> Optional[str]:
+        """Validate the XML ``example_path`` against the loaded schema."""
+        assert self._schema is not None, "Expected the schema to be loaded"
+
+        import xmlschema  # pylint: disable=import-outside-toplevel
+
+        try:
+            self._schema.validate(str(example_path))
+        except xmlschema.validators.exceptions.XMLSchemaValidationError as exception:
+            return str(exception.reason)
+
+        return None
+
+
+class ShaclValidator(Validator):
+    """Validate the Turtle examples with the ``pyshacl`` package."""
+
+    schema_file = "shacl-schema.ttl"
+    example_glob = "**/*.ttl"
+
+    def __init__(self) -> None:
+        """Initialize with no schema loaded."""
+        self._shacl_graph = None  # type: Optional[Any]
+        self._ontology_graph = None  # type: Optional[Any]
+
+    def load(self, output_dir: pathlib.Path) -> None:
+        """Load the SHACL schema and the ontology generated to ``output_dir``."""
+        import rdflib  # pylint: disable=import-outside-toplevel
+        import pyshacl  # pylint: disable=import-outside-toplevel,unused-import
+
+        self._shacl_graph = rdflib.Graph().parse(
+            str(output_dir / self.schema_file), format="turtle"
+        )
+        self._ontology_graph = rdflib.Graph().parse(
+            str(output_dir / "rdf-ontology.ttl"), format="turtle"
+        )
+
+    def validate(self, example_path: pathlib.Path) -> Optional[str]:
+        """Validate the Turtle ``example_path`` against the loaded schema."""
+        assert self._shacl_graph is not None, "Expected the schema to be loaded"
+
+        import pyshacl  # pylint: disable=import-outside-toplevel
+
+        conforms, _, results_text = pyshacl.validate(
+            str(example_path),
+            shacl_graph=self._shacl_graph,
+            ont_graph=self._ontology_graph,
+            data_graph_format="turtle",
+            inference="rdfs",
+        )
+
+        if not conforms:
+            return str(results_text)
+
+        return None
+
+
+#: Map the schema targets to the validators of their examples.
+#:
+#: Register your own validator here to test a further target or to replace
+#: the validation library of an existing one.
+VALIDATOR_BY_TARGET = {
+    aas_core_codegen.main.Target.JSONSCHEMA: JsonSchemaValidator,
+    aas_core_codegen.main.Target.XSD: XsdValidator,
+    aas_core_codegen.main.Target.RDF_SHACL: ShaclValidator,
+}  # type: MutableMapping[aas_core_codegen.main.Target, Type[Validator]]
+
+
+def execute(
+    params: aas_core_codegen.main.Parameters,
+    examples_dir: pathlib.Path,
+    stdout: TextIO,
+    stderr: TextIO,
+) -> int:
+    """
+    Generate the schema and validate the examples against it.
+
+    The examples in ``examples_dir / "expected"`` need to pass the validation,
+    while the examples in ``examples_dir / "unexpected"`` need to fail it.
+    """
+    validator_class = VALIDATOR_BY_TARGET.get(params.target, None)
+    if validator_class is None:
+        stderr.write(
+            f"There is no validator registered for the target "
+            f"{params.target.value!r}; the available targets are: "
+            f"{', '.join(target.value for target in VALIDATOR_BY_TARGET)}\n"
+        )
+        return 1
+
+    if not examples_dir.is_dir():
+        stderr.write(f"The --examples_dir is not a directory: {examples_dir}\n")
+        return 1
+
+    generation_stdout = io.StringIO()
+    return_code = aas_core_codegen.main.execute(
+        params=params, stdout=generation_stdout, stderr=stderr
+    )
+    if return_code != 0:
+        return return_code
+
+    validator = validator_class()  # type: Validator
+
+    try:
+        validator.load(output_dir=params.output_dir)
+    except Exception as exception:
+        run.write_error_report(
+            message=f"Failed to load the schema generated to {params.output_dir}",
+            errors=[str(exception)],
+            stderr=stderr,
+        )
+        return 1
+
+    expected_paths = sorted((examples_dir / "expected").glob(validator.example_glob))
+    unexpected_paths = sorted(
+        (examples_dir / "unexpected").glob(validator.example_glob)
+    )
+
+    errors = []  # type: List[str]
+
+    for example_path in expected_paths:
+        error_message = validator.validate(example_path)
+        if error_message is not None:
+            errors.append(
+                f"Expected the example {example_path} to be valid, "
+                f"but got: {error_message}"
+            )
+
+    for example_path in unexpected_paths:
+        if validator.validate(example_path) is None:
+            errors.append(
+                f"Expected the example {example_path} to be invalid, "
+                f"but it passed the validation"
+            )
+
+    if len(errors) > 0:
+        run.write_error_report(
+            message=f"Failed to test the {params.target.value} schema "
+            f"on the examples from {examples_dir}",
+            errors=errors,
+            stderr=stderr,
+        )
+        return 1
+
+    stdout.write(
+        f"Tested the {params.target.value} schema on {len(expected_paths)} "
+        f"expected and {len(unexpected_paths)} unexpected example(s).\n"
+    )
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument("--model_path", help="path to the meta-model", required=True)
+    parser.add_argument(
+        "--snippets_dir",
+        help="path to the directory containing implementation-specific code snippets",
+        required=True,
+    )
+    parser.add_argument(
+        "--examples_dir",
+        help=(
+            "path to the directory with the examples; "
+            "the examples in 'expected' subdirectory need to be valid, "
+            "the examples in 'unexpected' subdirectory need to be invalid"
+        ),
+        required=True,
+    )
+    parser.add_argument(
+        "--target",
+        help="schema to be generated and tested",
+        required=True,
+        choices=sorted(target.value for target in VALIDATOR_BY_TARGET),
+    )
+    parser.add_argument(
+        "--output_dir",
+        help=(
+            "path to the output directory for the generated schema; "
+            "if not given, the schema is generated to a temporary directory"
+        ),
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    with contextlib.ExitStack() as exit_stack:
+        if args.output_dir is not None:
+            output_dir = pathlib.Path(args.output_dir)
+        else:
+            # pylint: disable=consider-using-with
+            tmp_dir = tempfile.TemporaryDirectory()
+            exit_stack.push(tmp_dir)
+            output_dir = pathlib.Path(tmp_dir.name)
+
+        params = aas_core_codegen.main.Parameters(
+            model_path=pathlib.Path(args.model_path),
+            target=aas_core_codegen.main.Target(args.target),
+            snippets_dir=pathlib.Path(args.snippets_dir),
+            output_dir=output_dir,
+        )
+
+        return execute(
+            params=params,
+            examples_dir=pathlib.Path(args.examples_dir),
+            stdout=sys.stdout,
+            stderr=sys.stderr,
+        )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-test-schemas")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-test-schemas"))
@@ -60,7 +60,7 @@ def _smoke_transpile_to_csharp(symbol_table: intermediate.SymbolTable) -> List[E
 
 def execute(model_path: pathlib.Path, stderr: TextIO) -> int:
     """Run the smoke test."""
-    text = model_path.read_text(encoding="utf-8")
+    text = model_path.read_text(encoding="utf-8-sig")
 
     # BEFORE-RELEASE (mristin, 2021-12-13):
     #  test all the following individual failure cases
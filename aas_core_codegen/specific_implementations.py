@@ -35,7 +35,9 @@ def read_from_directory(
     mapping = dict()  # pylint: disable=use-dict-literal
 
     errors = []  # type: List[str]
-    for pth in snippets_dir.glob("**/*"):
+    # The order of the files given by the file system differs between the platforms,
+    # so we sort them to report the errors in the same order.
+    for pth in sorted(snippets_dir.glob("**/*")):
         if pth.is_dir():
             continue
 
@@ -48,7 +50,10 @@ def read_from_directory(
             continue
 
         key = ImplementationKey(maybe_key)
-        value = Stripped(pth.read_text(encoding="utf-8").strip())
+
+        # The editors on Windows often prepend a byte order mark to the files,
+        # which must not end up in the generated code.
+        value = Stripped(pth.read_text(encoding="utf-8-sig").strip())
         mapping[key] = value
 
     if errors:
@@ -0,0 +1 @@
+"""Generate type stubs for authoring meta-models in the DSL of the generator."""
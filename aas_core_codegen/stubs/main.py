@@ -0,0 +1,159 @@
+"""Generate type stubs for authoring meta-models in the DSL of the generator."""
+
+import argparse
+import pathlib
+import sys
+from typing import TextIO, Mapping
+
+import aas_core_codegen
+from aas_core_codegen import run
+from aas_core_codegen.common import Stripped
+import aas_core_codegen.stubs
+
+assert __doc__ == aas_core_codegen.stubs.__doc__
+
+# The stubs need to be kept in sync with the markers understood by
+# :py:mod:`aas_core_codegen.parse`. The unit tests check that all the expected
+# imports from ``aas_core_meta.marker`` are covered.
+MARKER_STUB = Stripped(
+    '''\
+"""Mark the meta-model with the information needed by aas-core-codegen."""
+from typing import Any, Callable, Optional, Sequence, Set, Tuple, Type, TypeVar
+
+T = TypeVar("T")
+CallableT = TypeVar("CallableT", bound=Callable[..., Any])
+ClassT = TypeVar("ClassT", bound=Type[Any])
+
+class ReferenceInTheBook:
+    """Reference a section of the book which the meta-model is based on."""
+
+    section: Tuple[int, ...]
+    index: int
+    fragment: Optional[str]
+    def __call__(self, thing: T) -> T: ...
+
+def reference_in_the_book(
+    section: Tuple[int, ...], index: int = 0, fragment: Optional[str] = None
+) -> ReferenceInTheBook:
+    """Reference a section of the book, either as a decorator or a value."""
+
+def abstract(cls: ClassT) -> ClassT:
+    """Mark the class as abstract."""
+
+def serialization(with_model_type: bool) -> Callable[[ClassT], ClassT]:
+    """Specify the general settings for serialization of the class."""
+
+def access_controlled(*property_names: str) -> Callable[[ClassT], ClassT]:
+    """Mark the properties of the class as subject to the access control."""
+
+def implementation_specific(thing: T) -> T:
+    """Mark the class or the function as implemented by hand in snippets."""
+
+def verification(func: CallableT) -> CallableT:
+    """Mark the function as a verification function."""
+
+def constant_bool(
+    value: bool,
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+) -> bool:
+    """Define a boolean constant."""
+
+def constant_int(
+    value: int,
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+) -> int:
+    """Define an integer constant."""
+
+def constant_float(
+    value: float,
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+) -> float:
+    """Define a floating-point constant."""
+
+def constant_str(
+    value: str,
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+) -> str:
+    """Define a string constant."""
+
+def constant_bytearray(
+    value: bytearray,
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+) -> bytearray:
+    """Define a byte-array constant."""
+
+def constant_set(
+    values: Sequence[T],
+    description: Optional[str] = None,
+    reference_in_the_book: Optional[ReferenceInTheBook] = None,
+    superset_of: Optional[Sequence[Set[T]]] = None,
+) -> Set[T]:
+    """Define a constant set of primitive values or enumeration literals."""'''
+)
+
+#: Map relative paths of the stub package to their content
+STUB_FILES = {
+    pathlib.Path("aas_core_meta") / "__init__.pyi": Stripped(
+        '"""Provide type stubs for authoring meta-models."""'
+    ),
+    pathlib.Path("aas_core_meta") / "marker.pyi": MARKER_STUB,
+}  # type: Mapping[pathlib.Path, Stripped]
+
+
+def execute(output_dir: pathlib.Path, stdout: TextIO, stderr: TextIO) -> int:
+    """Write the stub package to ``output_dir``."""
+    for relative_pth, content in STUB_FILES.items():
+        pth = output_dir / relative_pth
+
+        try:
+            run.extended_length_path(pth.parent).mkdir(
+                parents=True, exist_ok=True
+            )
+            run.write_text(path=pth, text=content + "\n")
+        except Exception as exception:
+            run.write_error_report(
+                message=f"Failed to write the stub to {pth}",
+                errors=[str(exception)],
+                stderr=stderr,
+            )
+            return 1
+
+    stdout.write(f"Stubs generated to: {output_dir}\n")
+    return 0
+
+
+def main(prog: str) -> int:
+    """Execute the main routine."""
+    # NOTE (mristin, 2022-03-28):
+    # The module ``argparse`` is not flexible enough to understand special options such
+    # as ``--version`` so we manually hard-wire.
+    if "--version" in sys.argv and "--help" not in sys.argv:
+        print(aas_core_codegen.__version__)
+        return 0
+
+    parser = argparse.ArgumentParser(prog=prog, description=__doc__)
+    parser.add_argument(
+        "--output_dir", help="path to the generated stub package", required=True
+    )
+    parser.add_argument(
+        "--version", help="show the current version and exit", action="store_true"
+    )
+    args = parser.parse_args()
+
+    return execute(
+        output_dir=pathlib.Path(args.output_dir), stdout=sys.stdout, stderr=sys.stderr
+    )
+
+
+def entry_point() -> int:
+    """Provide an entry point for a console script."""
+    return main(prog="aas-core-codegen-stubs")
+
+
+if __name__ == "__main__":
+    sys.exit(main(prog="aas-core-codegen-stubs"))
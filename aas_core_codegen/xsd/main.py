@@ -964,7 +964,7 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
 
     pth = context.output_dir / "schema.xsd"
     try:
-        pth.write_text(code, encoding="utf-8")
+        context.write_text(path=pth, text=code)
     except Exception as exception:
         run.write_error_report(
             message=f"Failed to write the XML Schema Definition to {pth}",
@@ -973,5 +973,8 @@ def execute(context: run.Context, stdout: TextIO, stderr: TextIO) -> int:
         )
         return 1
 
-    stdout.write(f"Code generated to: {context.output_dir}\n")
+    context.logger.info(
+        f"Code generated to: {context.output_dir}",
+        output_dir=str(context.output_dir),
+    )
     return 0
@@ -6,6 +6,7 @@ import pathlib
 import subprocess
 import sys
 import shlex
+from typing import List
 
 
 def main() -> int:
@@ -29,13 +30,13 @@ def main() -> int:
         help=(
             "If set, only the selected tests are executed. "
             "This is practical if some of the tests failed and you want to "
-            "fix them in isolation. "
-            "The tests are given as a space-separated list of: "
+            "fix them in isolation, or if you want to re-record only a single "
+            "target (e.g., tests.csharp) or concern. "
+            "The tests are given as a space-separated list of the prefixes of: "
             + " ".join(available_tests)
         ),
         metavar="",
         nargs="+",
-        choices=available_tests,
     )
     args = parser.parse_args()
 
@@ -46,7 +47,25 @@ def main() -> int:
     env["AAS_CORE_CODEGEN_RERECORD"] = "1"
 
     if args.select is not None:
-        tests_to_run = list(args.select)
+        tests_to_run = []  # type: List[str]
+        for prefix in args.select:
+            selected = [
+                test_name
+                for test_name in available_tests
+                if test_name == prefix or test_name.startswith(prefix + ".")
+            ]
+
+            if len(selected) == 0:
+                print(
+                    f"No test matches the selection {prefix!r}; "
+                    f"the available tests are: {' '.join(available_tests)}",
+                    file=sys.stderr,
+                )
+                return 1
+
+            tests_to_run.extend(
+                test_name for test_name in selected if test_name not in tests_to_run
+            )
     else:
         tests_to_run = available_tests
 
@@ -60,6 +79,15 @@ def main() -> int:
             print(f"Failed to execute the test: {cmd_str}")
             return 1
 
+    # We summarize the changes of the golden files so that the re-recording after,
+    # say, a naming change can be reviewed at a glance before committing.
+    print("Summary of the re-recorded golden files:")
+    exit_code = subprocess.call(
+        ["git", "diff", "--stat", "--", "test_data"], cwd=str(repo_root)
+    )
+    if exit_code != 0:
+        print("Failed to summarize the changes with git; please inspect them manually.")
+
     return 0
 
 
@@ -32,6 +32,8 @@ setup(
         "Programming Language :: Python :: 3.8",
         "Programming Language :: Python :: 3.9",
         "Programming Language :: Python :: 3.10",
+        "Programming Language :: Python :: 3.11",
+        "Programming Language :: Python :: 3.12",
     ],
     license="License :: OSI Approved :: MIT License",
     keywords="asset administration shell code generation industry 4.0 industrie i4.0",
@@ -51,7 +53,13 @@ setup(
             "jsonschema==3.2.0",
             "xmlschema==1.10.0",
             "aas-core-meta@git+https://github.com/aas-core-works/aas-core-meta@e808e37#egg=aas-core-meta",
-        ]
+        ],
+        "test_schemas": [
+            "jsonschema==3.2.0",
+            "xmlschema==1.10.0",
+            "pyshacl==0.19.1",
+            "rdflib==6.1.1",
+        ],
     },
     # fmt: on
     py_modules=["aas_core_codegen"],
@@ -61,6 +69,12 @@ setup(
         "console_scripts": [
             "aas-core-codegen=aas_core_codegen.main:entry_point",
             "aas-core-codegen-smoke=aas_core_codegen.smoke.main:entry_point",
+            "aas-core-codegen-stubs=aas_core_codegen.stubs.main:entry_point",
+            "aas-core-codegen-consistency=aas_core_codegen.consistency.main:entry_point",
+            "aas-core-codegen-test-schemas=aas_core_codegen.schema_tests.main:entry_point",
+            "aas-core-codegen-init=aas_core_codegen.init_project.main:entry_point",
+            "aas-core-codegen-explain=aas_core_codegen.explain.main:entry_point",
+            "aas-core-codegen-api-compat=aas_core_codegen.api_compat.main:entry_point",
         ]
     },
 )
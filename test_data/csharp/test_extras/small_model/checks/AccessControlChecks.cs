@@ -0,0 +1,66 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class AccessControlChecks
+    {
+        private class Policy : Aas.AccessControl.IPolicy
+        {
+            public readonly System.Collections.Generic.List<string> Asked = new();
+
+            public bool MayRead(Aas.IClass that)
+            {
+                return !(that is Aas.Note note && note.Id == "secret-note");
+            }
+
+            public bool MayRead(
+                Aas.IClass that, Aas.AccessControl.Property property)
+            {
+                Asked.Add(property.ToString());
+                return false;
+            }
+        }
+
+        public static void Run()
+        {
+            var blob = new Aas.Blob(
+                "some-blob",
+                category: "confidential",
+                value: new byte[] { 1, 2, 3 });
+            var secretNote = new Aas.Note("secret-note", "some text");
+            var container = new Aas.Container(
+                2,
+                true,
+                new System.Collections.Generic.List<Aas.ISomething>
+                {
+                    blob, secretNote
+                });
+
+            var policy = new Policy();
+            var enforced = Aas.AccessControl.Enforce(container, policy);
+
+            Check.Equal(1, enforced.Items!.Count, "Number of the readable items");
+
+            var enforcedBlob = (Aas.Blob)enforced.Items[0];
+            Check.Equal(null, enforcedBlob.Value, "Access-controlled property");
+            Check.Equal(
+                "confidential",
+                enforcedBlob.Category,
+                "Property which is not access-controlled");
+            Check.Equal(
+                "BlobValue",
+                string.Join(",", policy.Asked),
+                "Only the access-controlled properties are checked");
+
+            // The original instances are left untouched.
+            Check.Equal(3, blob.Value!.Length, "Original value of the blob");
+            Check.Equal(2, container.Items!.Count, "Original items");
+
+            var jsonObject = Aas.AccessControl.ToJsonObject(container, new Policy());
+            Check.Equal(
+                false, jsonObject.ToJsonString().Contains("secret-note"),
+                "Unreadable instance in the JSON");
+            Check.Equal(2, container.Items.Count, "Items after the serialization");
+        }
+    }
+}
@@ -0,0 +1,31 @@
+namespace Checks
+{
+    public static class Check
+    {
+        public static void Equal<T>(T expected, T got, string what)
+        {
+            if (!System.Collections.Generic.EqualityComparer<T>.Default.Equals(
+                    expected, got))
+            {
+                throw new System.InvalidOperationException(
+                    $"{what}: expected {expected}, but got {got}");
+            }
+        }
+
+        public static void Throws<TException>(System.Action action, string what)
+            where TException : System.Exception
+        {
+            try
+            {
+                action();
+            }
+            catch (TException)
+            {
+                return;
+            }
+
+            throw new System.InvalidOperationException(
+                $"{what}: expected {typeof(TException).Name} to be thrown");
+        }
+    }
+}
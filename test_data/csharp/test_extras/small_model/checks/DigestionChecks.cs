@@ -0,0 +1,74 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class DigestionChecks
+    {
+        private static string CanonicalJson(Aas.IClass that)
+        {
+            return System.Text.Encoding.UTF8.GetString(
+                Aas.Digestion.CanonicalJson(that));
+        }
+
+        public static void Run()
+        {
+            // The strings are escaped minimally, and the properties are sorted.
+            Check.Equal(
+                "{\"category\":\"<&>+é€\",\"id\":\"\\\"\\\\\\b\\f\\n\\r\\t\\u0001\"," +
+                "\"modelType\":\"Note\",\"text\":\"\u007f\"}",
+                CanonicalJson(
+                    new Aas.Note(
+                        "\"\\\b\f\n\r\t\u0001",
+                        "\u007f",
+                        category: "<&>+é€")),
+                "Escaping of the strings");
+
+            // The numbers are formatted as in ECMAScript.
+            var numbers = new (double, string)[]
+            {
+                (0.0, "0"),
+                (-0.0, "0"),
+                (1.0, "1"),
+                (-1.5, "-1.5"),
+                (0.1, "0.1"),
+                (123.456, "123.456"),
+                (1e20, "100000000000000000000"),
+                (1e21, "1e+21"),
+                (1.5e-7, "1.5e-7"),
+                (0.000001, "0.000001"),
+                (9007199254740992.0, "9007199254740992"),
+                (5e-324, "5e-324"),
+                (1.7976931348623157e308, "1.7976931348623157e+308")
+            };
+
+            foreach (var (number, expected) in numbers)
+            {
+                Check.Equal(
+                    $"{{\"id\":\"x\",\"modelType\":\"Note\",\"text\":\"y\",\"weight\":{expected}}}",
+                    CanonicalJson(new Aas.Note("x", "y", weight: number)),
+                    $"Formatting of {number:R}");
+            }
+
+            Check.Equal(
+                "{\"count\":42,\"enabled\":true}",
+                CanonicalJson(new Aas.Container(42, true)),
+                "Integers and booleans");
+
+            // The digest is computed over the canonical form.
+            var note = new Aas.Note("x", "y");
+            string canonical = CanonicalJson(note);
+            Check.Equal(
+                "{\"id\":\"x\",\"modelType\":\"Note\",\"text\":\"y\"}",
+                canonical,
+                "Canonical form of a note");
+
+            using var sha256 = System.Security.Cryptography.SHA256.Create();
+            string expectedDigest = System.BitConverter.ToString(
+                sha256.ComputeHash(System.Text.Encoding.UTF8.GetBytes(canonical)))
+                .Replace("-", "").ToLowerInvariant();
+
+            Check.Equal(expectedDigest, Aas.Digestion.Digest(note), "Digest");
+            Check.Equal($"\"{expectedDigest}\"", Aas.Digestion.ETag(note), "ETag");
+        }
+    }
+}
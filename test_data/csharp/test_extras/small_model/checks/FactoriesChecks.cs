@@ -0,0 +1,32 @@
+using System.Linq;
+
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class FactoriesChecks
+    {
+        private static int CountErrors(Aas.IClass that)
+        {
+            return Aas.Verification.Verify(that).Count();
+        }
+
+        public static void Run()
+        {
+            Check.Equal("something", Aas.Factories.CreateMinimalBlob().Id, "Blob ID");
+            Check.Equal(null, Aas.Factories.CreateMinimalBlob().Value, "Blob value");
+
+            // The minimal instances of this model are valid as all its invariants
+            // are inferred as constraints.
+            Check.Equal(0, CountErrors(Aas.Factories.CreateMinimalBlob()), "Blob");
+            Check.Equal(0, CountErrors(Aas.Factories.CreateMinimalNote()), "Note");
+            Check.Equal(
+                0, CountErrors(Aas.Factories.CreateMinimalContainer()), "Container");
+            Check.Equal(0, CountErrors(Aas.Factories.CreateMinimalTag()), "Tag");
+
+            // A value which does not satisfy the inferred constraints breaks
+            // the invariant.
+            Check.Equal(1, CountErrors(new Aas.Tag("something")), "Placeholder");
+        }
+    }
+}
@@ -0,0 +1,46 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class InstrumentationChecks
+    {
+        public static void Run()
+        {
+            var operations = new System.Collections.Generic.List<string>();
+
+            using var listener = new System.Diagnostics.ActivityListener
+            {
+                ShouldListenTo = source => source.Name == "Dummy",
+                Sample = (
+                        ref System.Diagnostics.ActivityCreationOptions<
+                            System.Diagnostics.ActivityContext> _) =>
+                    System.Diagnostics.ActivitySamplingResult.AllData,
+                ActivityStopped = activity => operations.Add(activity.OperationName)
+            };
+            System.Diagnostics.ActivitySource.AddActivityListener(listener);
+
+            var note = new Aas.Note("some-note", "some text");
+
+            var jsonNote = Aas.Instrumentation.NoteFromJson(
+                Aas.Instrumentation.ToJsonObject(note));
+            Check.Equal("some-note", jsonNote.Id, "Note round-tripped over JSON");
+
+            var builder = new System.Text.StringBuilder();
+            using (var writer = System.Xml.XmlWriter.Create(builder))
+            {
+                Aas.Instrumentation.ToXml(note, writer);
+            }
+
+            using var reader = System.Xml.XmlReader.Create(
+                new System.IO.StringReader(builder.ToString()));
+            reader.MoveToContent();
+            var xmlNote = Aas.Instrumentation.NoteFromXml(reader);
+            Check.Equal("some-note", xmlNote.Id, "Note round-tripped over XML");
+
+            Check.Equal(
+                "ToJsonObject,NoteFromJson,ToXml,NoteFromXml",
+                string.Join(",", operations),
+                "Traced operations");
+        }
+    }
+}
@@ -0,0 +1,70 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class InterningChecks
+    {
+        public static void Run()
+        {
+            var interned = new System.Collections.Generic.List<string>();
+            System.Func<string, string> intern = text =>
+            {
+                interned.Add(text);
+                return string.Intern(text);
+            };
+
+            var note = new Aas.Note("some-note", "some text", category: "public");
+
+            Aas.Jsonization.Deserialize.Intern = intern;
+            try
+            {
+                var jsonNote = Aas.Jsonization.Deserialize.NoteFrom(
+                    Aas.Jsonization.Serialize.ToJsonObject(note));
+                Check.Equal(
+                    true,
+                    ReferenceEquals(string.Intern("some-note"), jsonNote.Id),
+                    "Interned identifier from JSON");
+            }
+            finally
+            {
+                Aas.Jsonization.Deserialize.Intern = null;
+            }
+
+            Check.Equal(
+                "some-note,public,some text",
+                string.Join(",", interned),
+                "Strings interned in the JSON deserialization");
+
+            interned.Clear();
+
+            var builder = new System.Text.StringBuilder();
+            using (var writer = System.Xml.XmlWriter.Create(builder))
+            {
+                Aas.Xmlization.Serialize.To(note, writer);
+            }
+
+            using var reader = System.Xml.XmlReader.Create(
+                new System.IO.StringReader(builder.ToString()));
+            reader.MoveToContent();
+
+            Aas.Xmlization.Deserialize.Intern = intern;
+            try
+            {
+                var xmlNote = Aas.Xmlization.Deserialize.NoteFrom(reader);
+                Check.Equal(
+                    true,
+                    ReferenceEquals(string.Intern("some-note"), xmlNote.Id),
+                    "Interned identifier from XML");
+            }
+            finally
+            {
+                Aas.Xmlization.Deserialize.Intern = null;
+            }
+
+            Check.Equal(
+                "some-note,public,some text",
+                string.Join(",", interned),
+                "Strings interned in the XML deserialization");
+        }
+    }
+}
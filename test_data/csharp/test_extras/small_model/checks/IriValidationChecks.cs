@@ -0,0 +1,27 @@
+using System.Linq;  // can't alias
+
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class IriValidationChecks
+    {
+        private static int CountErrors(string source)
+        {
+            var tag = new Aas.Tag("abc", source);
+            return Aas.Verification.Verify(tag).Count();
+        }
+
+        public static void Run()
+        {
+            Check.Equal(0, CountErrors("https://example.com/a%20b"), "Valid IRI");
+            Check.Equal(1, CountErrors("https://exa mple.com"), "White space in IRI");
+            Check.Equal(1, CountErrors("example.com"), "Missing scheme");
+
+            // The regular expression of the meta-model accepts this IRI, so
+            // the error shows that the verification delegates to the validator.
+            Check.Equal(
+                1, CountErrors("https://example.com/%zz"), "Malformed percent-encoding");
+        }
+    }
+}
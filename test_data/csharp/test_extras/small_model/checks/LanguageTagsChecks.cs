@@ -0,0 +1,32 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class LanguageTagsChecks
+    {
+        public static void Run()
+        {
+            Check.Equal(
+                "en-Latn-US", Aas.LanguageTags.Normalize("EN-latn-us"), "Normalize");
+            Check.Equal(
+                "de-x-private", Aas.LanguageTags.Normalize("DE-X-PRIVATE"),
+                "Normalize after a singleton");
+            Check.Equal("de", Aas.LanguageTags.PrimaryLanguage("DE-CH"), "Primary");
+
+            Check.Equal(true, Aas.LanguageTags.Matches("de-CH", "DE"), "Prefix match");
+            Check.Equal(false, Aas.LanguageTags.Matches("dex", "de"), "Not a subtag");
+            Check.Equal(true, Aas.LanguageTags.Matches("fr", "*"), "Wildcard");
+
+            // The culture-invariant case mapping would turn the Kelvin sign
+            // (U+212A) into the ASCII k, so only the ASCII letters are mapped.
+            Check.Equal(
+                "en-\u212AR", Aas.LanguageTags.Normalize("en-\u212Ar"),
+                "Non-ASCII subtag");
+            Check.Equal(
+                "\u212Ao", Aas.LanguageTags.PrimaryLanguage("\u212AO-KR"),
+                "Non-ASCII primary language");
+            Check.Equal(
+                false, Aas.LanguageTags.Matches("\u212Ao-KR", "ko"), "Non-ASCII match");
+        }
+    }
+}
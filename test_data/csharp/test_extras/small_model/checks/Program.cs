@@ -0,0 +1,23 @@
+namespace Checks
+{
+    public static class Program
+    {
+        public static int Main()
+        {
+            DigestionChecks.Run();
+            RedactionChecks.Run();
+            AccessControlChecks.Run();
+            InstrumentationChecks.Run();
+            InterningChecks.Run();
+            IriValidationChecks.Run();
+            StatisticsChecks.Run();
+            FactoriesChecks.Run();
+            SigningChecks.Run();
+            VerificationCacheChecks.Run();
+            LanguageTagsChecks.Run();
+
+            System.Console.WriteLine("All the checks passed.");
+            return 0;
+        }
+    }
+}
@@ -0,0 +1,69 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class RedactionChecks
+    {
+        public static void Run()
+        {
+            var blob = new Aas.Blob(
+                "some-blob",
+                category: "confidential",
+                value: new byte[] { 1, 2, 3 },
+                kind: Aas.Kind.Instance);
+            var note = new Aas.Note(
+                "some-note", "some text", category: "public", weight: 1.5);
+            var pinned = new Aas.Note(
+                "pinned-note", "pinned text", category: "confidential");
+            var container = new Aas.Container(
+                2,
+                true,
+                new System.Collections.Generic.List<Aas.ISomething>
+                {
+                    blob, note
+                },
+                pinned);
+
+            // The inherited property is stripped from all the descendants.
+            var redacted = Aas.Redaction.Redact(
+                container,
+                new Aas.Redaction.Filter(
+                    new[]
+                    {
+                        Aas.Redaction.Property.SomethingCategory,
+                        Aas.Redaction.Property.BlobValue
+                    }));
+
+            var redactedBlob = (Aas.Blob)redacted.Items![0];
+            var redactedNote = (Aas.Note)redacted.Items[1];
+
+            Check.Equal(null, redactedBlob.Category, "Inherited property of a blob");
+            Check.Equal(null, redactedNote.Category, "Inherited property of a note");
+            Check.Equal(null, redacted.Pinned!.Category, "Property of a nested note");
+            Check.Equal(null, redactedBlob.Value, "Property of a blob");
+            Check.Equal(
+                Aas.Kind.Instance, redactedBlob.Kind, "Property not in the filter");
+            Check.Equal(1.5, redactedNote.Weight, "Property not in the filter");
+            Check.Equal("some-blob", redactedBlob.Id, "Required property");
+            Check.Equal(2, redacted.Count, "Required property of the container");
+
+            // The original instances are left untouched.
+            Check.Equal("confidential", blob.Category, "Original blob");
+            Check.Equal(3, blob.Value!.Length, "Original value of the blob");
+            Check.Equal("confidential", pinned.Category, "Original nested note");
+            Check.Equal(2, container.Items!.Count, "Original items");
+
+            // The instances are removed from the lists.
+            var filtered = Aas.Redaction.Redact(
+                container,
+                new Aas.Redaction.Filter(
+                    new Aas.Redaction.Property[] { },
+                    that => that is Aas.Blob));
+
+            Check.Equal(1, filtered.Items!.Count, "Number of the remaining items");
+            Check.Equal(
+                "some-note", filtered.Items[0].Id, "Remaining item");
+            Check.Equal(2, container.Items.Count, "Items of the original container");
+        }
+    }
+}
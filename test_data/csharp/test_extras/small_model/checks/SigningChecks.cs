@@ -0,0 +1,66 @@
+using ECCurve = System.Security.Cryptography.ECCurve;
+using ECDsa = System.Security.Cryptography.ECDsa;
+using Nodes = System.Text.Json.Nodes;
+
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class SigningChecks
+    {
+        public static void Run()
+        {
+            var note = new Aas.Note("some-note", "some text");
+
+            using var key = ECDsa.Create(ECCurve.NamedCurves.nistP256);
+
+            var signature = Aas.Signing.Sign(note, key, keyId: "some-key");
+            Check.Equal("ES256", signature.Algorithm, "Algorithm");
+            Check.Equal("some-key", signature.KeyId, "Key ID");
+            Check.Equal(true, Aas.Signing.Verify(note, signature, key), "Valid");
+
+            var jsonObject = Aas.Signing.ToJsonObject(signature);
+            var deserialized = Aas.Signing.SignatureFrom(
+                Nodes.JsonNode.Parse(jsonObject.ToJsonString())!);
+            Check.Equal("ES256", deserialized.Algorithm, "Deserialized algorithm");
+            Check.Equal("SHA-256", deserialized.HashAlgorithm, "Deserialized hash");
+            Check.Equal("some-key", deserialized.KeyId, "Deserialized key ID");
+            Check.Equal(
+                true, Aas.Signing.Verify(note, deserialized, key), "Deserialized");
+
+            Check.Throws<Aas.Jsonization.Exception>(
+                () => Aas.Signing.SignatureFrom(
+                    Nodes.JsonNode.Parse("{\"algorithm\": \"ES256\"}")!),
+                "Deserializing without the required properties");
+
+            var otherHash = new Aas.Signing.Signature(
+                signature.Algorithm, signature.Value, signature.KeyId, "SHA-512");
+            Check.Equal(
+                false, Aas.Signing.Verify(note, otherHash, key), "Other hash");
+
+            note.Text = "tampered";
+            Check.Equal(false, Aas.Signing.Verify(note, signature, key), "Tampered");
+
+            // The other curves would require a different hash for their algorithm.
+            foreach (var curve in new[]
+                     {
+                         ECCurve.NamedCurves.nistP384,
+                         ECCurve.NamedCurves.nistP521
+                     })
+            {
+                using var otherKey = ECDsa.Create(curve);
+
+                Check.Throws<System.ArgumentException>(
+                    () => Aas.Signing.Sign(note, otherKey),
+                    $"Signing with a key of size {otherKey.KeySize}");
+
+                var forged = Aas.Signing.Sign(
+                    note, "ES256", otherKey.SignHash);
+                Check.Equal(
+                    false,
+                    Aas.Signing.Verify(note, forged, otherKey),
+                    $"Verifying with a key of size {otherKey.KeySize}");
+            }
+        }
+    }
+}
@@ -0,0 +1,30 @@
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class StatisticsChecks
+    {
+        public static void Run()
+        {
+            var container = new Aas.Container(
+                2, true, new System.Collections.Generic.List<Aas.ISomething>
+                {
+                    new Aas.Blob(
+                        "blob", category: "abc", value: new byte[] { 1, 2, 3 }),
+                    new Aas.Note("note", "some text")
+                });
+
+            var stats = Aas.Statistics.Compute(container);
+
+            Check.Equal(3L, stats.Count, "Number of instances");
+            Check.Equal(1L, stats.CountByClass["Container"], "Number of containers");
+            Check.Equal(1L, stats.CountByClass["Blob"], "Number of blobs");
+            Check.Equal(1L, stats.CountByClass["Note"], "Number of notes");
+
+            // "blob" + "abc" + "note" + "some text"
+            Check.Equal(20L, stats.StringPayload, "String payload");
+            Check.Equal(3L, stats.BytePayload, "Byte payload");
+            Check.Equal(2, stats.MaxDepth, "Maximum depth");
+        }
+    }
+}
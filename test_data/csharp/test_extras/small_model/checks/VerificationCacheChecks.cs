@@ -0,0 +1,54 @@
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Checks
+{
+    public static class VerificationCacheChecks
+    {
+        private static string PathOfFirst(List<Aas.Reporting.Error> errors)
+        {
+            return Aas.Reporting.GenerateJsonPath(errors[0].PathSegments);
+        }
+
+        public static void Run()
+        {
+            var blob = new Aas.Blob("some-blob", kind: (Aas.Kind)42);
+            var container = new Aas.Container(
+                0, true, items: new List<Aas.ISomething> { blob });
+
+            var cache = new Aas.Verification.Cache();
+
+            var errors = Aas.Verification.Verify(container, cache);
+            Check.Equal(1, errors.Count, "Errors");
+            Check.Equal("items[0].kind", PathOfFirst(errors), "Path");
+
+            // The cached errors must not accumulate the path segments.
+            errors = Aas.Verification.Verify(container, cache);
+            Check.Equal(1, errors.Count, "Errors from the cache");
+            Check.Equal("items[0].kind", PathOfFirst(errors), "Path from the cache");
+
+            // The cache does not observe the modifications.
+            blob.Kind = Aas.Kind.Instance;
+            Check.Equal(
+                1, Aas.Verification.Verify(container, cache).Count, "Stale");
+
+            cache.Invalidate(blob);
+            cache.Invalidate(container);
+            Check.Equal(
+                0, Aas.Verification.Verify(container, cache).Count, "Invalidated");
+
+            // The cache can be shared between the threads.
+            var counts = new int[16];
+            System.Threading.Tasks.Parallel.For(
+                0,
+                counts.Length,
+                i => counts[i] = Aas.Verification.Verify(
+                    new Aas.Container(-1, true), cache).Count);
+            foreach (var count in counts)
+            {
+                Check.Equal(1, count, "Concurrent");
+            }
+        }
+    }
+}
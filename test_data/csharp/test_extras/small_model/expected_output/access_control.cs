@@ -0,0 +1,118 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Provide hooks for servers to enforce their access control on the data
+    /// before it leaves the server.
+    /// </summary>
+    public static class AccessControl
+    {
+        /// <summary>
+        /// Enumerate the properties marked as access-controlled in the meta-model.
+        /// </summary>
+        /// <remarks>
+        /// The literals are named after the class which declares the property.
+        /// </remarks>
+        public enum Property
+        {
+            BlobValue
+        }  // public enum Property
+
+        /// <summary>
+        /// Decide which instances and properties the current subject may read.
+        /// </summary>
+        /// <remarks>
+        /// Implement this interface to plug in your attribute-based access control.
+        /// </remarks>
+        public interface IPolicy
+        {
+            /// <summary>
+            /// Check whether <paramref name="that" /> may be read at all.
+            /// </summary>
+            /// <remarks>
+            /// Only the instances contained in lists are checked, so that
+            /// the structure of the parent instances remains intact.
+            /// </remarks>
+            bool MayRead(Aas.IClass that);
+
+            /// <summary>
+            /// Check whether the access-controlled <paramref name="property" /> of
+            /// <paramref name="that" /> may be read.
+            /// </summary>
+            /// <remarks>
+            /// Only the properties which are set are checked.
+            /// </remarks>
+            bool MayRead(Aas.IClass that, Property property);
+        }  // public interface IPolicy
+
+        /// <summary>
+        /// Redact the instances according to a policy.
+        /// </summary>
+        /// <remarks>
+        /// Only the access-controlled properties are checked against the policy.
+        /// </remarks>
+        private class PolicyFilter : Redaction.IFilter
+        {
+            private readonly IPolicy _policy;
+
+            public PolicyFilter(IPolicy policy)
+            {
+                _policy = policy;
+            }
+
+            public bool Strips(Aas.IClass that, Redaction.Property property)
+            {
+                switch (property)
+                {
+                    case Redaction.Property.BlobValue:
+                        return !_policy.MayRead(that, Property.BlobValue);
+                    default:
+                        return false;
+                }
+            }
+
+            public bool Excludes(Aas.IClass that)
+            {
+                return !_policy.MayRead(that);
+            }
+        }  // private class PolicyFilter
+
+        /// <summary>
+        /// Make a copy of <paramref name="that" /> and all its descendants redacted
+        /// according to <paramref name="policy" />.
+        /// </summary>
+        /// <remarks>
+        /// The original instances are left untouched. The enforced instances are
+        /// not necessarily valid anymore, similar to <see cref="Redaction.Redact" />.
+        /// </remarks>
+        public static T Enforce<T>(T that, IPolicy policy) where T : Aas.IClass
+        {
+            return Redaction.Redact(that, new PolicyFilter(policy));
+        }
+
+        /// <summary>
+        /// Serialize <paramref name="that" /> to JSON with <paramref name="policy" />
+        /// enforced.
+        /// </summary>
+        /// <remarks>
+        /// The original instances are left untouched.
+        /// </remarks>
+        public static System.Text.Json.Nodes.JsonObject ToJsonObject(
+            Aas.IClass that,
+            IPolicy policy)
+        {
+            return Jsonization.Serialize.ToJsonObject(Enforce(that, policy));
+        }
+    }  // public static class AccessControl
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,22 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using System.Collections.Generic;  // can't alias
+
+namespace Dummy
+{
+    /// <summary>
+    /// Provide constant values of the meta-model.
+    /// </summary>
+    public static class Constants
+    {
+
+    }  // public static class Constants
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,328 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using Nodes = System.Text.Json.Nodes;
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Compute stable digests of model instances, e.g., for change detection.
+    /// </summary>
+    public static class Digestion
+    {
+        /// <summary>
+        /// Write <paramref name="text" /> as a canonical JSON string
+        /// to <paramref name="builder" />.
+        /// </summary>
+        /// <remarks>
+        /// Only the quotation mark, the backslash and the control characters are
+        /// escaped as required by RFC 8785. All the other characters, including
+        /// the non-ASCII ones, are written as-is.
+        /// </remarks>
+        private static void WriteCanonicalString(
+            string text,
+            System.Text.StringBuilder builder)
+        {
+            builder.Append('"');
+            foreach (char character in text)
+            {
+                switch (character)
+                {
+                    case '"':
+                        builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        builder.Append("\\\\");
+                        break;
+                    case '\b':
+                        builder.Append("\\b");
+                        break;
+                    case '\f':
+                        builder.Append("\\f");
+                        break;
+                    case '\n':
+                        builder.Append("\\n");
+                        break;
+                    case '\r':
+                        builder.Append("\\r");
+                        break;
+                    case '\t':
+                        builder.Append("\\t");
+                        break;
+                    default:
+                        if (character < 0x20)
+                        {
+                            builder.Append("\\u");
+                            builder.Append(((int)character).ToString("x4"));
+                        }
+                        else
+                        {
+                            builder.Append(character);
+                        }
+                        break;
+                }
+            }
+            builder.Append('"');
+        }
+
+        /// <summary>
+        /// Write <paramref name="number" /> as a canonical JSON number
+        /// to <paramref name="builder" />.
+        /// </summary>
+        /// <remarks>
+        /// The number is formatted as in ECMAScript (<c>Number.prototype.toString</c>)
+        /// as required by RFC 8785, e.g., <c>1e+21</c> or <c>1.5e-7</c>.
+        /// </remarks>
+        /// <exception cref="System.ArgumentException">
+        /// Thrown when <paramref name="number" /> is not finite.
+        /// </exception>
+        private static void WriteCanonicalNumber(
+            double number,
+            System.Text.StringBuilder builder)
+        {
+            if (double.IsNaN(number) || double.IsInfinity(number))
+            {
+                throw new System.ArgumentException(
+                    $"Expected a finite number, but got: {number}");
+            }
+
+            // Negative zero is also written as 0.
+            if (number == 0)
+            {
+                builder.Append('0');
+                return;
+            }
+
+            if (number < 0)
+            {
+                builder.Append('-');
+                number = -number;
+            }
+
+            // The format "R" gives the shortest representation which round-trips.
+            string roundTrip = number.ToString(
+                "R",
+                System.Globalization.CultureInfo.InvariantCulture);
+
+            string mantissa = roundTrip;
+            int exponent = 0;
+            int exponentStart = roundTrip.IndexOf('E');
+            if (exponentStart >= 0)
+            {
+                mantissa = roundTrip.Substring(0, exponentStart);
+                exponent = int.Parse(
+                    roundTrip.Substring(exponentStart + 1),
+                    System.Globalization.NumberStyles.AllowLeadingSign,
+                    System.Globalization.CultureInfo.InvariantCulture);
+            }
+
+            // We decompose the number as 0.d1...dk * 10^n.
+            string digits;
+            int n;
+            int point = mantissa.IndexOf('.');
+            if (point >= 0)
+            {
+                digits = mantissa.Substring(0, point) + mantissa.Substring(point + 1);
+                n = point + exponent;
+            }
+            else
+            {
+                digits = mantissa;
+                n = mantissa.Length + exponent;
+            }
+
+            int leadingZeros = 0;
+            while (digits[leadingZeros] == '0')
+            {
+                leadingZeros++;
+            }
+            digits = digits.Substring(leadingZeros).TrimEnd('0');
+            n -= leadingZeros;
+
+            int k = digits.Length;
+
+            if (k <= n && n <= 21)
+            {
+                builder.Append(digits);
+                builder.Append('0', n - k);
+            }
+            else if (0 < n && n <= 21)
+            {
+                builder.Append(digits, 0, n);
+                builder.Append('.');
+                builder.Append(digits, n, k - n);
+            }
+            else if (-6 < n && n <= 0)
+            {
+                builder.Append("0.");
+                builder.Append('0', -n);
+                builder.Append(digits);
+            }
+            else
+            {
+                builder.Append(digits[0]);
+                if (k > 1)
+                {
+                    builder.Append('.');
+                    builder.Append(digits, 1, k - 1);
+                }
+                builder.Append('e');
+                builder.Append(n - 1 >= 0 ? '+' : '-');
+                builder.Append(System.Math.Abs(n - 1));
+            }
+        }
+
+        /// <summary>
+        /// Write <paramref name="node" /> in the canonical form
+        /// to <paramref name="builder" />.
+        /// </summary>
+        /// <remarks>
+        /// The properties of JSON objects are sorted by the UTF-16 code units
+        /// of their names as required by RFC 8785 so that the output does not
+        /// depend on the order in which the serializer emitted them.
+        /// </remarks>
+        private static void WriteCanonical(
+            Nodes.JsonNode? node,
+            System.Text.StringBuilder builder)
+        {
+            switch (node)
+            {
+                case null:
+                    builder.Append("null");
+                    break;
+                case Nodes.JsonObject jsonObject:
+                {
+                    var keys = new List<string>();
+                    foreach (var pair in jsonObject)
+                    {
+                        keys.Add(pair.Key);
+                    }
+                    keys.Sort(System.StringComparer.Ordinal);
+
+                    builder.Append('{');
+                    for (int i = 0; i < keys.Count; i++)
+                    {
+                        if (i > 0)
+                        {
+                            builder.Append(',');
+                        }
+
+                        WriteCanonicalString(keys[i], builder);
+                        builder.Append(':');
+                        WriteCanonical(jsonObject[keys[i]], builder);
+                    }
+                    builder.Append('}');
+                    break;
+                }
+                case Nodes.JsonArray jsonArray:
+                {
+                    builder.Append('[');
+                    for (int i = 0; i < jsonArray.Count; i++)
+                    {
+                        if (i > 0)
+                        {
+                            builder.Append(',');
+                        }
+
+                        WriteCanonical(jsonArray[i], builder);
+                    }
+                    builder.Append(']');
+                    break;
+                }
+                case Nodes.JsonValue jsonValue:
+                {
+                    if (jsonValue.TryGetValue<string>(out string? text))
+                    {
+                        WriteCanonicalString(text, builder);
+                    }
+                    else if (jsonValue.TryGetValue<bool>(out bool boolean))
+                    {
+                        builder.Append(boolean ? "true" : "false");
+                    }
+                    else
+                    {
+                        double number = double.Parse(
+                            jsonValue.ToJsonString(),
+                            System.Globalization.NumberStyles.Float,
+                            System.Globalization.CultureInfo.InvariantCulture);
+                        WriteCanonicalNumber(number, builder);
+                    }
+                    break;
+                }
+                default:
+                    throw new System.ArgumentException(
+                        $"Unexpected JSON node: {node.GetType()}");
+            }
+        }
+
+        /// <summary>
+        /// Serialize <paramref name="that" /> to canonical UTF-8 encoded JSON.
+        /// </summary>
+        /// <remarks>
+        /// The canonical form follows the JSON Canonicalization Scheme
+        /// (JCS, RFC 8785), so that equal instances always result in equal bytes.
+        /// As required by JCS, the numbers are handled as IEEE 754 doubles so
+        /// the integers beyond 2^53 lose their precision.
+        /// </remarks>
+        public static byte[] CanonicalJson(Aas.IClass that)
+        {
+            Nodes.JsonObject jsonObject = Jsonization.Serialize.ToJsonObject(that);
+
+            var builder = new System.Text.StringBuilder();
+            WriteCanonical(jsonObject, builder);
+
+            return System.Text.Encoding.UTF8.GetBytes(builder.ToString());
+        }
+
+        /// <summary>
+        /// Compute the SHA-256 digest of <paramref name="that" />
+        /// as a lowercase hexadecimal string.
+        /// </summary>
+        /// <remarks>
+        /// The digest is computed over <see cref="CanonicalJson" />, so that
+        /// it is stable across runs and platforms, and equals the digest computed
+        /// by any other implementation of RFC 8785 over the same JSON.
+        /// </remarks>
+        public static string Digest(Aas.IClass that)
+        {
+            byte[] canonical = CanonicalJson(that);
+
+            byte[] hash;
+            using (var sha256 = System.Security.Cryptography.SHA256.Create())
+            {
+                hash = sha256.ComputeHash(canonical);
+            }
+
+            var builder = new System.Text.StringBuilder(2 * hash.Length);
+            foreach (byte b in hash)
+            {
+                builder.Append(b.ToString("x2"));
+            }
+
+            return builder.ToString();
+        }
+
+        /// <summary>
+        /// Compute a strong entity tag (ETag) of <paramref name="that" />
+        /// based on its <see cref="Digest" />.
+        /// </summary>
+        /// <remarks>
+        /// The result is quoted as required by RFC 7232 so that it can be
+        /// directly used as the value of the <c>ETag</c> HTTP header.
+        /// </remarks>
+        public static string ETag(Aas.ISomething that)
+        {
+            return $"\"{Digest(that)}\"";
+        }
+    }  // public static class Digestion
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
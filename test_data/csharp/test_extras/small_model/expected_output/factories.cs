@@ -0,0 +1,77 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Create minimal instances of the model, e.g., for unit tests and tutorials.
+    /// </summary>
+    /// <remarks>
+    /// <para>
+    /// The required properties are set to values which satisfy the constraints
+    /// on their length, their patterns and their sets of allowed values.
+    /// The other invariants of the model are <em>not</em> guaranteed to hold,
+    /// so call <see cref="Verification.Verify(Aas.IClass)" /> if you need valid
+    /// instances.
+    /// </para>
+    /// <para>
+    /// The values of constrained primitives can be given explicitly by
+    /// the snippets <c>Minimal/{name of the constrained primitive}.cs</c>.
+    /// </para>
+    /// </remarks>
+    public static class Factories
+    {
+        /// <summary>
+        /// Create a minimal instance of <see cref="Aas.Blob" />
+        /// with only the required properties set.
+        /// </summary>
+        public static Aas.Blob CreateMinimalBlob()
+        {
+            return new Aas.Blob(
+                id: "something");
+        }
+
+        /// <summary>
+        /// Create a minimal instance of <see cref="Aas.Note" />
+        /// with only the required properties set.
+        /// </summary>
+        public static Aas.Note CreateMinimalNote()
+        {
+            return new Aas.Note(
+                id: "something",
+                text: "something");
+        }
+
+        /// <summary>
+        /// Create a minimal instance of <see cref="Aas.Container" />
+        /// with only the required properties set.
+        /// </summary>
+        public static Aas.Container CreateMinimalContainer()
+        {
+            return new Aas.Container(
+                count: 0,
+                enabled: false);
+        }
+
+        /// <summary>
+        /// Create a minimal instance of <see cref="Aas.Tag" />
+        /// with only the required properties set.
+        /// </summary>
+        public static Aas.Tag CreateMinimalTag()
+        {
+            return new Aas.Tag(
+                label: "som");
+        }
+    }  // public static class Factories
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
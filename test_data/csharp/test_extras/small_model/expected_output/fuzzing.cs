@@ -0,0 +1,164 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using Nodes = System.Text.Json.Nodes;
+using Xml = System.Xml;
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Provide the harnesses for fuzzing the deserialization.
+    /// </summary>
+    /// <remarks>
+    /// The harnesses do not depend on any fuzzing engine so that you can plug them
+    /// into SharpFuzz, libFuzzer or any other engine of your choice.
+    /// </remarks>
+    public static class Fuzzing
+    {
+        private static Aas.IClass DeserializeJson(byte selector, Nodes.JsonNode node)
+        {
+            switch (selector % 4)
+            {
+                case 0:
+                    return Jsonization.Deserialize.BlobFrom(node);
+                case 1:
+                    return Jsonization.Deserialize.NoteFrom(node);
+                case 2:
+                    return Jsonization.Deserialize.ContainerFrom(node);
+                case 3:
+                    return Jsonization.Deserialize.TagFrom(node);
+                default:
+                    throw new System.InvalidOperationException(
+                        $"Unexpected selector: {selector}");
+            }
+        }
+
+        /// <summary>
+        /// Fuzz the JSON deserialization with <paramref name="data" />.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The first byte selects the class to be deserialized, while the remaining
+        /// bytes are parsed as UTF-8 JSON. A successfully deserialized instance is
+        /// further serialized and verified.
+        /// </para>
+        /// <para>
+        /// The errors expected on invalid input are swallowed. Any other exception
+        /// is a finding. Pass this function to your fuzzing engine, <em>e.g.</em>,
+        /// <c>SharpFuzz.Fuzzer.LibFuzzer.Run(Fuzzing.FuzzJson)</c>.
+        /// </para>
+        /// </remarks>
+        public static void FuzzJson(System.ReadOnlySpan<byte> data)
+        {
+            if (data.Length == 0)
+            {
+                return;
+            }
+
+            Nodes.JsonNode? node;
+            try
+            {
+                node = Nodes.JsonNode.Parse(data.Slice(1));
+            }
+            catch (System.Text.Json.JsonException)
+            {
+                return;
+            }
+
+            if (node == null)
+            {
+                return;
+            }
+
+            Aas.IClass instance;
+            try
+            {
+                instance = DeserializeJson(data[0], node);
+            }
+            catch (Jsonization.Exception)
+            {
+                return;
+            }
+
+            Jsonization.Serialize.ToJsonObject(instance);
+
+            foreach (var _ in Verification.Verify(instance))
+            {
+                // Intentionally empty.
+            }
+        }
+
+        private static Aas.IClass DeserializeXml(byte selector, Xml.XmlReader reader)
+        {
+            switch (selector % 4)
+            {
+                case 0:
+                    return Xmlization.Deserialize.BlobFrom(reader);
+                case 1:
+                    return Xmlization.Deserialize.NoteFrom(reader);
+                case 2:
+                    return Xmlization.Deserialize.ContainerFrom(reader);
+                case 3:
+                    return Xmlization.Deserialize.TagFrom(reader);
+                default:
+                    throw new System.InvalidOperationException(
+                        $"Unexpected selector: {selector}");
+            }
+        }
+
+        /// <summary>
+        /// Fuzz the XML deserialization with <paramref name="data" />.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The first byte selects the class to be deserialized, while the remaining
+        /// bytes are read as an XML document. A successfully deserialized instance is
+        /// further verified.
+        /// </para>
+        /// <para>
+        /// The errors expected on invalid input are swallowed. Any other exception
+        /// is a finding. Pass this function to your fuzzing engine, <em>e.g.</em>,
+        /// <c>SharpFuzz.Fuzzer.LibFuzzer.Run(Fuzzing.FuzzXml)</c>.
+        /// </para>
+        /// </remarks>
+        public static void FuzzXml(System.ReadOnlySpan<byte> data)
+        {
+            if (data.Length == 0)
+            {
+                return;
+            }
+
+            using var stream = new System.IO.MemoryStream(data.Slice(1).ToArray());
+            using var reader = Xml.XmlReader.Create(stream);
+
+            Aas.IClass instance;
+            try
+            {
+                reader.MoveToContent();
+                instance = DeserializeXml(data[0], reader);
+            }
+            catch (Xml.XmlException)
+            {
+                return;
+            }
+            catch (Xmlization.Exception)
+            {
+                return;
+            }
+
+            foreach (var _ in Verification.Verify(instance))
+            {
+                // Intentionally empty.
+            }
+        }
+    }  // public static class Fuzzing
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
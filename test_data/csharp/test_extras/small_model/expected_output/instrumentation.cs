@@ -0,0 +1,241 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+#if AAS_CORE_INSTRUMENTATION
+
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Trace and measure the JSON and XML deserialization, the verification and
+    /// the JSON and XML serialization so that the operators can see where
+    /// the processing time goes.
+    /// </summary>
+    /// <remarks>
+    /// The spans and metrics follow <c>System.Diagnostics</c> and can be
+    /// exported with OpenTelemetry by listening to <see cref="ActivitySource" />
+    /// and <see cref="Meter" />. The operation of a span or a metric is named
+    /// after the corresponding method, e.g., <c>ToXml</c>.
+    /// </remarks>
+    public static class Instrumentation
+    {
+        /// <summary>
+        /// Source of the tracing spans, one per call of an entry point
+        /// </summary>
+        public static readonly System.Diagnostics.ActivitySource ActivitySource =
+            new System.Diagnostics.ActivitySource("Dummy");
+
+        /// <summary>
+        /// Meter of the processing time and the errors of the entry points
+        /// </summary>
+        public static readonly System.Diagnostics.Metrics.Meter Meter =
+            new System.Diagnostics.Metrics.Meter("Dummy");
+
+        private static readonly System.Diagnostics.Metrics.Histogram<double> Duration =
+            Meter.CreateHistogram<double>(
+                "aas.duration", "ms", "Time spent in an entry point");
+
+        private static readonly System.Diagnostics.Metrics.Counter<long> Failures =
+            Meter.CreateCounter<long>(
+                "aas.failures", null, "Number of entry point calls which threw");
+
+        private static T Measure<T>(string operation, System.Func<T> action)
+        {
+            using var activity = ActivitySource.StartActivity(operation);
+            var tag = new KeyValuePair<string, object?>("operation", operation);
+            var stopwatch = System.Diagnostics.Stopwatch.StartNew();
+
+            try
+            {
+                return action();
+            }
+            catch (System.Exception exception)
+            {
+                activity?.SetStatus(
+                    System.Diagnostics.ActivityStatusCode.Error, exception.Message);
+                Failures.Add(1, tag);
+                throw;
+            }
+            finally
+            {
+                Duration.Record(stopwatch.Elapsed.TotalMilliseconds, tag);
+            }
+        }
+
+        private static void Measure(string operation, System.Action action)
+        {
+            Measure<object?>(
+                operation,
+                () =>
+                {
+                    action();
+                    return null;
+                });
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Deserialize.ISomethingFrom" />.
+        /// </summary>
+        public static Aas.ISomething ISomethingFromJson(
+            System.Text.Json.Nodes.JsonNode node)
+        {
+            return Measure(
+                "ISomethingFromJson",
+                () => Jsonization.Deserialize.ISomethingFrom(node));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Deserialize.ISomethingFrom" />.
+        /// </summary>
+        public static Aas.ISomething ISomethingFromXml(
+            System.Xml.XmlReader reader,
+            string? ns = null)
+        {
+            return Measure(
+                "ISomethingFromXml",
+                () => Xmlization.Deserialize.ISomethingFrom(reader, ns));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Deserialize.BlobFrom" />.
+        /// </summary>
+        public static Aas.Blob BlobFromJson(
+            System.Text.Json.Nodes.JsonNode node)
+        {
+            return Measure(
+                "BlobFromJson",
+                () => Jsonization.Deserialize.BlobFrom(node));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Deserialize.BlobFrom" />.
+        /// </summary>
+        public static Aas.Blob BlobFromXml(
+            System.Xml.XmlReader reader,
+            string? ns = null)
+        {
+            return Measure(
+                "BlobFromXml",
+                () => Xmlization.Deserialize.BlobFrom(reader, ns));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Deserialize.NoteFrom" />.
+        /// </summary>
+        public static Aas.Note NoteFromJson(
+            System.Text.Json.Nodes.JsonNode node)
+        {
+            return Measure(
+                "NoteFromJson",
+                () => Jsonization.Deserialize.NoteFrom(node));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Deserialize.NoteFrom" />.
+        /// </summary>
+        public static Aas.Note NoteFromXml(
+            System.Xml.XmlReader reader,
+            string? ns = null)
+        {
+            return Measure(
+                "NoteFromXml",
+                () => Xmlization.Deserialize.NoteFrom(reader, ns));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Deserialize.ContainerFrom" />.
+        /// </summary>
+        public static Aas.Container ContainerFromJson(
+            System.Text.Json.Nodes.JsonNode node)
+        {
+            return Measure(
+                "ContainerFromJson",
+                () => Jsonization.Deserialize.ContainerFrom(node));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Deserialize.ContainerFrom" />.
+        /// </summary>
+        public static Aas.Container ContainerFromXml(
+            System.Xml.XmlReader reader,
+            string? ns = null)
+        {
+            return Measure(
+                "ContainerFromXml",
+                () => Xmlization.Deserialize.ContainerFrom(reader, ns));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Deserialize.TagFrom" />.
+        /// </summary>
+        public static Aas.Tag TagFromJson(
+            System.Text.Json.Nodes.JsonNode node)
+        {
+            return Measure(
+                "TagFromJson",
+                () => Jsonization.Deserialize.TagFrom(node));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Deserialize.TagFrom" />.
+        /// </summary>
+        public static Aas.Tag TagFromXml(
+            System.Xml.XmlReader reader,
+            string? ns = null)
+        {
+            return Measure(
+                "TagFromXml",
+                () => Xmlization.Deserialize.TagFrom(reader, ns));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Verification.Verify" />.
+        /// </summary>
+        /// <remarks>
+        /// The errors are collected eagerly so that the measurement covers
+        /// the whole verification.
+        /// </remarks>
+        public static List<Reporting.Error> Verify(Aas.IClass that)
+        {
+            return Measure(
+                "Verify",
+                () => new List<Reporting.Error>(Verification.Verify(that)));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Jsonization.Serialize.ToJsonObject" />.
+        /// </summary>
+        public static System.Text.Json.Nodes.JsonObject ToJsonObject(Aas.IClass that)
+        {
+            return Measure(
+                "ToJsonObject",
+                () => Jsonization.Serialize.ToJsonObject(that));
+        }
+
+        /// <summary>
+        /// Trace and measure <see cref="Xmlization.Serialize.To" />.
+        /// </summary>
+        public static void ToXml(
+            Aas.IClass that,
+            System.Xml.XmlWriter writer,
+            string? prefix = null,
+            string? ns = null)
+        {
+            Measure(
+                "ToXml",
+                () => Xmlization.Serialize.To(that, writer, prefix, ns));
+        }
+    }  // public static class Instrumentation
+}  // namespace Dummy
+
+#endif  // AAS_CORE_INSTRUMENTATION
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
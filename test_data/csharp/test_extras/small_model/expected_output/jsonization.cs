@@ -0,0 +1,1251 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using CodeAnalysis = System.Diagnostics.CodeAnalysis;
+using Nodes = System.Text.Json.Nodes;
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Provide de/serialization of meta-model classes to/from JSON.
+    /// </summary>
+    /// <remarks>
+    /// We can not use one-pass deserialization for JSON since the object
+    /// properties do not have fixed order, and hence we can not read
+    /// <c>modelType</c> property ahead of the remaining properties.
+    /// </remarks>
+    public static class Jsonization
+    {
+        /// <summary>
+        /// Implement the deserialization of meta-model classes from JSON nodes.
+        /// </summary>
+        /// <remarks>
+        /// The implementation propagates an <see cref="Reporting.Error" /> instead of relying
+        /// on exceptions. Under the assumption that incorrect data is much less
+        /// frequent than correct data, this makes the deserialization more
+        /// efficient.
+        ///
+        /// However, we do not want to force the client to deal with
+        /// the <see cref="Reporting.Error" /> class as this is not intuitive. Therefore
+        /// we distinguish the implementation, realized in
+        /// <see cref="DeserializeImplementation" />, and the facade given in
+        /// <see cref="Deserialize" /> class.
+        /// </remarks>
+        internal static class DeserializeImplementation
+        {
+            /// <summary>Convert <paramref name="node" /> to a boolean.</summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static bool? BoolFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                Nodes.JsonValue? value = node as Nodes.JsonValue;
+                if (value == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonValue, but got {node.GetType()}");
+                    return null;
+                }
+                bool ok = value.TryGetValue<bool>(out bool result);
+                if (!ok)
+                {
+                    error = new Reporting.Error(
+                        "Expected a boolean, but the conversion failed " +
+                        $"from {value.ToJsonString()}");
+                    return null;
+                }
+                return result;
+            }
+
+            /// <summary>
+            /// Convert the <paramref name="node" /> to a long 64-bit integer.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static long? LongFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                Nodes.JsonValue? value = node as Nodes.JsonValue;
+                if (value == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonValue, but got {node.GetType()}");
+                    return null;
+                }
+                bool ok = value.TryGetValue<long>(out long result);
+                if (!ok)
+                {
+                    error = new Reporting.Error(
+                        "Expected a 64-bit long integer, but the conversion failed " +
+                        $"from {value.ToJsonString()}");
+                    return null;
+                }
+                return result;
+            }
+
+            /// <summary>
+            /// Convert the <paramref name="node" /> to a double-precision 64-bit float.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static double? DoubleFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                Nodes.JsonValue? value = node as Nodes.JsonValue;
+                if (value == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonValue, but got {node.GetType()}");
+                    return null;
+                }
+                bool ok = value.TryGetValue<double>(out double result);
+                if (!ok)
+                {
+                    error = new Reporting.Error(
+                        "Expected a 64-bit double-precision float, " +
+                        "but the conversion failed " +
+                        $"from {value.ToJsonString()}");
+                    return null;
+                }
+                return result;
+            }
+
+            /// <summary>
+            /// Convert the <paramref name="node" /> to a string.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static string? StringFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                Nodes.JsonValue? value = node as Nodes.JsonValue;
+                if (value == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonValue, but got {node.GetType()}");
+                    return null;
+                }
+                bool ok = value.TryGetValue<string>(out string? result);
+                if (!ok)
+                {
+                    error = new Reporting.Error(
+                        "Expected a string, but the conversion failed " +
+                        $"from {value.ToJsonString()}");
+                    return null;
+                }
+                if (result == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected a string, but got a null");
+                    return null;
+                }
+                var intern = Deserialize.Intern;
+                return (intern != null)
+                    ? intern(result)
+                    : result;
+            }
+
+            /// <summary>
+            /// Convert the <paramref name="node" /> to bytes.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static byte[]? BytesFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                Nodes.JsonValue? value = node as Nodes.JsonValue;
+                if (value == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonValue, but got {node.GetType()}");
+                    return null;
+                }
+                bool ok = value.TryGetValue<string>(out string? text);
+                if (!ok)
+                {
+                    error = new Reporting.Error(
+                        "Expected a string, but the conversion failed " +
+                        $"from {value.ToJsonString()}");
+                    return null;
+                }
+                if (text == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected a string, but got a null");
+                    return null;
+                }
+                try
+                {
+                    return System.Convert.FromBase64String(text);
+                }
+                catch (System.FormatException exception)
+                {
+                    error = new Reporting.Error(
+                        "Expected Base-64 encoded bytes, but the conversion failed " +
+                        $"because: {exception}");
+                    return null;
+                }
+            }
+
+            /// <summary>
+            /// Deserialize the enumeration Kind from the <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static Aas.Kind? KindFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+                string? text = DeserializeImplementation.StringFrom(
+                    node, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+                if (text == null)
+                {
+                    throw new System.InvalidOperationException(
+                        "Unexpected text null if error null");
+                }
+                Aas.Kind? result = Stringification.KindFromString(text);
+                if (result == null)
+                {
+                    error = new Reporting.Error(
+                        "Not a valid JSON representation of Kind");
+                }
+                return result;
+            }  // internal static KindFrom
+
+            /// <summary>
+            /// Deserialize an instance of ISomething by dispatching
+            /// based on <c>modelType</c> property of the <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
+            public static Aas.ISomething? ISomethingFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                var obj = node as Nodes.JsonObject;
+                if (obj == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected Nodes.JsonObject, but got {node.GetType()}");
+                    return null;
+                }
+
+                Nodes.JsonNode? modelTypeNode = obj["modelType"];
+                if (modelTypeNode == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected a model type, but none is present");
+                    return null;
+                }
+                Nodes.JsonValue? modelTypeValue = modelTypeNode as Nodes.JsonValue;
+                if (modelTypeValue == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected JsonValue, " +
+                        $"but got {modelTypeNode.GetType()}");
+                    return null;
+                }
+                modelTypeValue.TryGetValue<string>(out string? modelType);
+                if (modelType == null)
+                {
+                    error = new Reporting.Error(
+                        "Expected a string, " +
+                        $"but the conversion failed from {modelTypeValue}");
+                    return null;
+                }
+
+                switch (modelType)
+                {
+                    case "Blob":
+                        return BlobFrom(
+                            node, out error);
+                    case "Note":
+                        return NoteFrom(
+                            node, out error);
+                    default:
+                        error = new Reporting.Error(
+                            $"Unexpected model type for ISomething: {modelType}");
+                        return null;
+                }
+            }  // public static Aas.ISomething ISomethingFrom
+
+            /// <summary>
+            /// Deserialize an instance of Blob from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static Aas.Blob? BlobFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                Nodes.JsonObject? obj = node as Nodes.JsonObject;
+                if (obj == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonObject, but got {node.GetType()}");
+                    return null;
+                }
+
+                string? theId = null;
+                string? theCategory = null;
+                byte[]? theValue = null;
+                Kind? theKind = null;
+
+                foreach (var keyValue in obj)
+                {
+                    switch (keyValue.Key)
+                    {
+                        case "id":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theId = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "id"));
+                                return null;
+                            }
+                            if (theId == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theId null when error is also null");
+                            }
+                            break;
+                        }
+                        case "category":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theCategory = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "category"));
+                                return null;
+                            }
+                            if (theCategory == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theCategory null when error is also null");
+                            }
+                            break;
+                        }
+                        case "value":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theValue = DeserializeImplementation.BytesFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "value"));
+                                return null;
+                            }
+                            if (theValue == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theValue null when error is also null");
+                            }
+                            break;
+                        }
+                        case "kind":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theKind = DeserializeImplementation.KindFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "kind"));
+                                return null;
+                            }
+                            if (theKind == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theKind null when error is also null");
+                            }
+                            break;
+                        }
+                        case "modelType":
+                            continue;
+                        default:
+                            error = new Reporting.Error(
+                                $"Unexpected property: {keyValue.Key}");
+                            return null;
+                    }
+                }
+
+                if (theId == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"id\" is missing");
+                    return null;
+                }
+
+                return new Aas.Blob(
+                    theId
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theCategory,
+                    theValue,
+                    theKind);
+            }  // internal static BlobFrom
+
+            /// <summary>
+            /// Deserialize an instance of Note from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static Aas.Note? NoteFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                Nodes.JsonObject? obj = node as Nodes.JsonObject;
+                if (obj == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonObject, but got {node.GetType()}");
+                    return null;
+                }
+
+                string? theId = null;
+                string? theText = null;
+                string? theCategory = null;
+                double? theWeight = null;
+
+                foreach (var keyValue in obj)
+                {
+                    switch (keyValue.Key)
+                    {
+                        case "id":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theId = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "id"));
+                                return null;
+                            }
+                            if (theId == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theId null when error is also null");
+                            }
+                            break;
+                        }
+                        case "text":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theText = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "text"));
+                                return null;
+                            }
+                            if (theText == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theText null when error is also null");
+                            }
+                            break;
+                        }
+                        case "category":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theCategory = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "category"));
+                                return null;
+                            }
+                            if (theCategory == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theCategory null when error is also null");
+                            }
+                            break;
+                        }
+                        case "weight":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theWeight = DeserializeImplementation.DoubleFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "weight"));
+                                return null;
+                            }
+                            if (theWeight == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theWeight null when error is also null");
+                            }
+                            break;
+                        }
+                        case "modelType":
+                            continue;
+                        default:
+                            error = new Reporting.Error(
+                                $"Unexpected property: {keyValue.Key}");
+                            return null;
+                    }
+                }
+
+                if (theId == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"id\" is missing");
+                    return null;
+                }
+
+                if (theText == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"text\" is missing");
+                    return null;
+                }
+
+                return new Aas.Note(
+                    theId
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theText
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theCategory,
+                    theWeight);
+            }  // internal static NoteFrom
+
+            /// <summary>
+            /// Deserialize an instance of Container from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static Aas.Container? ContainerFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                Nodes.JsonObject? obj = node as Nodes.JsonObject;
+                if (obj == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonObject, but got {node.GetType()}");
+                    return null;
+                }
+
+                long? theCount = null;
+                bool? theEnabled = null;
+                List<ISomething>? theItems = null;
+                Note? thePinned = null;
+
+                foreach (var keyValue in obj)
+                {
+                    switch (keyValue.Key)
+                    {
+                        case "count":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theCount = DeserializeImplementation.LongFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "count"));
+                                return null;
+                            }
+                            if (theCount == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theCount null when error is also null");
+                            }
+                            break;
+                        }
+                        case "enabled":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theEnabled = DeserializeImplementation.BoolFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "enabled"));
+                                return null;
+                            }
+                            if (theEnabled == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theEnabled null when error is also null");
+                            }
+                            break;
+                        }
+                        case "items":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            Nodes.JsonArray? arrayItems = keyValue.Value as Nodes.JsonArray;
+                            if (arrayItems == null)
+                            {
+                                error = new Reporting.Error(
+                                    $"Expected a JsonArray, but got {keyValue.Value.GetType()}");
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "items"));
+                                return null;
+                            }
+                            theItems = new List<ISomething>(
+                                arrayItems.Count);
+                            int indexItems = 0;
+                            foreach (Nodes.JsonNode? item in arrayItems)
+                            {
+                                if (item == null)
+                                {
+                                    error = new Reporting.Error(
+                                        "Expected a non-null item, but got a null");
+                                    error.PrependSegment(
+                                        new Reporting.IndexSegment(
+                                            indexItems));
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "items"));
+                                    return null;
+                                }
+                                ISomething? parsedItem = DeserializeImplementation.ISomethingFrom(
+                                    item ?? throw new System.InvalidOperationException(),
+                                    out error);
+                                if (error != null)
+                                {
+                                    error.PrependSegment(
+                                        new Reporting.IndexSegment(
+                                            indexItems));
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "items"));
+                                    return null;
+                                }
+                                theItems.Add(
+                                    parsedItem
+                                        ?? throw new System.InvalidOperationException(
+                                            "Unexpected result null when error is null"));
+                                indexItems++;
+                            }
+                            break;
+                        }
+                        case "pinned":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            thePinned = DeserializeImplementation.NoteFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "pinned"));
+                                return null;
+                            }
+                            if (thePinned == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected thePinned null when error is also null");
+                            }
+                            break;
+                        }
+                        default:
+                            error = new Reporting.Error(
+                                $"Unexpected property: {keyValue.Key}");
+                            return null;
+                    }
+                }
+
+                if (theCount == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"count\" is missing");
+                    return null;
+                }
+
+                if (theEnabled == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"enabled\" is missing");
+                    return null;
+                }
+
+                return new Aas.Container(
+                    theCount
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theEnabled
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theItems,
+                    thePinned);
+            }  // internal static ContainerFrom
+
+            /// <summary>
+            /// Deserialize an instance of Tag from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <param name="error">Error, if any, during the deserialization</param>
+            internal static Aas.Tag? TagFrom(
+                Nodes.JsonNode node,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                Nodes.JsonObject? obj = node as Nodes.JsonObject;
+                if (obj == null)
+                {
+                    error = new Reporting.Error(
+                        $"Expected a JsonObject, but got {node.GetType()}");
+                    return null;
+                }
+
+                string? theLabel = null;
+                string? theSource = null;
+
+                foreach (var keyValue in obj)
+                {
+                    switch (keyValue.Key)
+                    {
+                        case "label":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theLabel = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "label"));
+                                return null;
+                            }
+                            if (theLabel == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theLabel null when error is also null");
+                            }
+                            break;
+                        }
+                        case "source":
+                        {
+                            if (keyValue.Value == null)
+                            {
+                                continue;
+                            }
+
+                            theSource = DeserializeImplementation.StringFrom(
+                                keyValue.Value,
+                                out error);
+                            if (error != null)
+                            {
+                                error.PrependSegment(
+                                    new Reporting.NameSegment(
+                                        "source"));
+                                return null;
+                            }
+                            if (theSource == null)
+                            {
+                                throw new System.InvalidOperationException(
+                                    "Unexpected theSource null when error is also null");
+                            }
+                            break;
+                        }
+                        default:
+                            error = new Reporting.Error(
+                                $"Unexpected property: {keyValue.Key}");
+                            return null;
+                    }
+                }
+
+                if (theLabel == null)
+                {
+                    error = new Reporting.Error(
+                        "Required property \"label\" is missing");
+                    return null;
+                }
+
+                return new Aas.Tag(
+                    theLabel
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theSource);
+            }  // internal static TagFrom
+        }  // public static class DeserializeImplementation
+
+        /// <summary>
+        /// Represent a critical error during the deserialization.
+        /// </summary>
+        public class Exception : System.Exception
+        {
+            public readonly string Path;
+            public readonly string Cause;
+            public Exception(string path, string cause)
+                : base($"{cause} at: {path}")
+            {
+                Path = path;
+                Cause = cause;
+            }
+        }
+
+        /// <summary>
+        /// Deserialize instances of meta-model classes from JSON nodes.
+        /// </summary>
+        /// <example>
+        /// Here is an example how to parse an instance of ISomething:
+        /// <code>
+        /// string someString = "... some JSON ...";
+        /// var node = System.Text.Json.Nodes.JsonNode.Parse(someString);
+        /// Aas.ISomething anInstance = Deserialize.ISomethingFrom(
+        ///     node);
+        /// </code>
+        /// </example>
+        public static class Deserialize
+        {
+            /// <summary>
+            /// If set, intern the deserialized strings with this function.
+            /// </summary>
+            /// <remarks>
+            /// Large data sets often repeat the same strings. Plug in, for example,
+            /// <see cref="string.Intern" /> or your own pool so that all the occurrences
+            /// of a string share a single instance.
+            /// </remarks>
+            public static System.Func<string, string>? Intern { get; set; }
+
+            /// <summary>
+            /// Deserialize an instance of Kind from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of Kind.
+            /// </exception>
+            public static Aas.Kind KindFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.Kind? result = DeserializeImplementation.KindFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of ISomething from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of ISomething.
+            /// </exception>
+            [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
+            public static Aas.ISomething ISomethingFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.ISomething? result = DeserializeImplementation.ISomethingFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Blob from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of Blob.
+            /// </exception>
+            public static Aas.Blob BlobFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.Blob? result = DeserializeImplementation.BlobFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Note from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of Note.
+            /// </exception>
+            public static Aas.Note NoteFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.Note? result = DeserializeImplementation.NoteFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Container from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of Container.
+            /// </exception>
+            public static Aas.Container ContainerFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.Container? result = DeserializeImplementation.ContainerFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Tag from <paramref name="node" />.
+            /// </summary>
+            /// <param name="node">JSON node to be parsed</param>
+            /// <exception cref="Jsonization.Exception">
+            /// Thrown when <paramref name="node" /> is not a valid JSON
+            /// representation of Tag.
+            /// </exception>
+            public static Aas.Tag TagFrom(
+                Nodes.JsonNode node)
+            {
+                Aas.Tag? result = DeserializeImplementation.TagFrom(
+                    node,
+                    out Reporting.Error? error);
+                if (error != null)
+                {
+                    throw new Jsonization.Exception(
+                        Reporting.GenerateJsonPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+        }  // public static class Deserialize
+
+        internal class Transformer
+            : Visitation.AbstractTransformer<Nodes.JsonObject>
+        {
+            /// <summary>
+            /// Convert <paramref name="that" /> 64-bit long integer to a JSON value.
+            /// </summary>
+            /// <param name="that">value to be converted</param>
+            /// <exception name="System.ArgumentException">
+            /// Thrown if <paramref name="that" /> is not within the range where it
+            /// can be losslessly converted to a double floating number.
+            /// </exception>
+            [CodeAnalysis.SuppressMessage("ReSharper", "UnusedMember.Local")]
+            private static Nodes.JsonValue ToJsonValue(long that)
+            {
+                // We need to check that we can perform a lossless conversion.
+                if ((long)((double)that) != that)
+                {
+                    throw new System.ArgumentException(
+                        $"The number can not be losslessly represented in JSON: {that}");
+                }
+                return Nodes.JsonValue.Create(that);
+            }
+
+            public override Nodes.JsonObject Transform(Aas.Blob that)
+            {
+                var result = new Nodes.JsonObject();
+
+                result["id"] = Nodes.JsonValue.Create(
+                    that.Id);
+
+                if (that.Category != null)
+                {
+                    result["category"] = Nodes.JsonValue.Create(
+                        that.Category);
+                }
+
+                if (that.Value != null)
+                {
+                    result["value"] = Nodes.JsonValue.Create(
+                        System.Convert.ToBase64String(
+                            that.Value));
+                }
+
+                if (that.Kind != null)
+                {
+                    // We need to help the static analyzer with a null coalescing.
+                    Aas.Kind value = that.Kind
+                        ?? throw new System.InvalidOperationException();
+                    result["kind"] = Serialize.KindToJsonValue(
+                        value);
+                }
+
+                result["modelType"] = "Blob";
+
+                return result;
+            }
+
+            public override Nodes.JsonObject Transform(Aas.Note that)
+            {
+                var result = new Nodes.JsonObject();
+
+                result["id"] = Nodes.JsonValue.Create(
+                    that.Id);
+
+                if (that.Category != null)
+                {
+                    result["category"] = Nodes.JsonValue.Create(
+                        that.Category);
+                }
+
+                result["text"] = Nodes.JsonValue.Create(
+                    that.Text);
+
+                if (that.Weight != null)
+                {
+                    result["weight"] = Nodes.JsonValue.Create(
+                        that.Weight);
+                }
+
+                result["modelType"] = "Note";
+
+                return result;
+            }
+
+            public override Nodes.JsonObject Transform(Aas.Container that)
+            {
+                var result = new Nodes.JsonObject();
+
+                if (that.Items != null)
+                {
+                    var arrayItems = new Nodes.JsonArray();
+                    foreach (ISomething item in that.Items)
+                    {
+                        arrayItems.Add(
+                            Transform(
+                                item));
+                    }
+                    result["items"] = arrayItems;
+                }
+
+                if (that.Pinned != null)
+                {
+                    result["pinned"] = Transform(
+                        that.Pinned);
+                }
+
+                result["count"] = Transformer.ToJsonValue(
+                    that.Count);
+
+                result["enabled"] = Nodes.JsonValue.Create(
+                    that.Enabled);
+
+                return result;
+            }
+
+            public override Nodes.JsonObject Transform(Aas.Tag that)
+            {
+                var result = new Nodes.JsonObject();
+
+                result["label"] = Nodes.JsonValue.Create(
+                    that.Label);
+
+                if (that.Source != null)
+                {
+                    result["source"] = Nodes.JsonValue.Create(
+                        that.Source);
+                }
+
+                return result;
+            }
+        }  // internal class Transformer
+
+        /// <summary>
+        /// Serialize instances of meta-model classes to JSON elements.
+        /// </summary>
+        /// <example>
+        /// Here is an example how to serialize an instance of ISomething:
+        /// <code>
+        /// var anInstance = new Aas.ISomething(
+        ///     // ... some constructor arguments ...
+        /// );
+        /// System.Text.Json.Nodes.JsonObject element = (
+        ///     Serialize.ToJsonObject(
+        ///         anInstance));
+        /// </code>
+        /// </example>
+        public static class Serialize
+        {
+            private static readonly Transformer Transformer = new Transformer();
+
+            /// <summary>
+            /// Serialize an instance of the meta-model into a JSON object.
+            /// </summary>
+            public static Nodes.JsonObject ToJsonObject(Aas.IClass that)
+            {
+                return Serialize.Transformer.Transform(that);
+            }
+
+            /// <summary>
+            /// Serialize a literal of Kind into a JSON string.
+            /// </summary>
+            public static Nodes.JsonValue KindToJsonValue(Aas.Kind that)
+            {
+                string? text = Stringification.ToString(that);
+                return Nodes.JsonValue.Create(text)
+                    ?? throw new System.ArgumentException(
+                        $"Invalid Kind: {that}");
+            }
+        }  // public static class Serialize
+    }  // public static class Jsonization
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
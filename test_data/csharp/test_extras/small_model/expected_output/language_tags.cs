@@ -0,0 +1,148 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+namespace Dummy
+{
+    /// <summary>
+    /// Handle the BCP 47 language tags of the multi-language strings.
+    /// </summary>
+    /// <remarks>
+    /// The tags are assumed to be already verified to be well-formed.
+    /// </remarks>
+    public static class LanguageTags
+    {
+        /// <summary>
+        /// Map the ASCII letters of <paramref name="text" /> to lower case.
+        /// </summary>
+        /// <remarks>
+        /// The subtags consist only of ASCII letters and digits. We leave all
+        /// the other characters as they are since the culture-invariant case mapping
+        /// would turn some of them into ASCII letters, e.g., the Kelvin sign into
+        /// <c>k</c>.
+        /// </remarks>
+        private static string ToLowerAscii(string text)
+        {
+            var chars = text.ToCharArray();
+            for (int i = 0; i < chars.Length; i++)
+            {
+                if (chars[i] >= 'A' && chars[i] <= 'Z')
+                {
+                    chars[i] = (char)(chars[i] + ('a' - 'A'));
+                }
+            }
+
+            return new string(chars);
+        }
+
+        /// <summary>
+        /// Map the ASCII letters of <paramref name="text" /> to upper case.
+        /// </summary>
+        /// <remarks>
+        /// See <see cref="ToLowerAscii" /> why we map only the ASCII letters.
+        /// </remarks>
+        private static string ToUpperAscii(string text)
+        {
+            var chars = text.ToCharArray();
+            for (int i = 0; i < chars.Length; i++)
+            {
+                if (chars[i] >= 'a' && chars[i] <= 'z')
+                {
+                    chars[i] = (char)(chars[i] - ('a' - 'A'));
+                }
+            }
+
+            return new string(chars);
+        }
+
+        /// <summary>
+        /// Normalize the case of the language <paramref name="tag" /> as recommended
+        /// in RFC 5646, e.g., <c>EN-latn-us</c> becomes <c>en-Latn-US</c>.
+        /// </summary>
+        /// <remarks>
+        /// The language tags are case-insensitive. The regions are written in
+        /// upper case, the scripts in title case and all the other subtags in
+        /// lower case. The subtags after a singleton, such as the extensions and
+        /// the private use, are all written in lower case.
+        /// </remarks>
+        public static string Normalize(string tag)
+        {
+            string[] subtags = tag.Split('-');
+
+            bool afterSingleton = false;
+            for (int i = 0; i < subtags.Length; i++)
+            {
+                string subtag = ToLowerAscii(subtags[i]);
+
+                if (i > 0 && !afterSingleton)
+                {
+                    if (subtag.Length == 2)
+                    {
+                        subtag = ToUpperAscii(subtag);
+                    }
+                    else if (subtag.Length == 4 && subtag[0] >= 'a' && subtag[0] <= 'z')
+                    {
+                        subtag = ToUpperAscii(subtag.Substring(0, 1)) + subtag.Substring(1);
+                    }
+                }
+
+                if (subtag.Length == 1)
+                {
+                    afterSingleton = true;
+                }
+
+                subtags[i] = subtag;
+            }
+
+            return string.Join("-", subtags);
+        }
+
+        /// <summary>
+        /// Extract the primary language subtag of the language <paramref name="tag" />
+        /// in lower case, e.g., <c>de</c> for <c>de-CH</c>.
+        /// </summary>
+        public static string PrimaryLanguage(string tag)
+        {
+            int end = tag.IndexOf('-');
+            return ToLowerAscii(end < 0 ? tag : tag.Substring(0, end));
+        }
+
+        /// <summary>
+        /// Check whether the language <paramref name="tag" /> matches
+        /// the language <paramref name="range" /> according to the basic filtering
+        /// of RFC 4647.
+        /// </summary>
+        /// <remarks>
+        /// For example, the range <c>de</c> matches <c>de</c> and <c>de-CH</c>,
+        /// but not <c>dex</c>. The range <c>*</c> matches all the tags.
+        ///
+        /// Only the ASCII letters are compared case-insensitively.
+        /// </remarks>
+        public static bool Matches(string tag, string range)
+        {
+            if (range == "*")
+            {
+                return true;
+            }
+
+            string lowerTag = ToLowerAscii(tag);
+            string lowerRange = ToLowerAscii(range);
+
+            if (lowerTag.Length == lowerRange.Length)
+            {
+                return string.Equals(
+                    lowerTag, lowerRange, System.StringComparison.Ordinal);
+            }
+
+            return lowerTag.Length > lowerRange.Length
+                && lowerTag[lowerRange.Length] == '-'
+                && lowerTag.StartsWith(lowerRange, System.StringComparison.Ordinal);
+        }
+    }  // public static class LanguageTags
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,196 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Strip properties and instances from the model, e.g., before sharing
+    /// the data with third parties.
+    /// </summary>
+    public static class Redaction
+    {
+        /// <summary>
+        /// Enumerate the properties which can be stripped.
+        /// </summary>
+        /// <remarks>
+        /// The literals are named after the class which declares the property so that
+        /// stripping an inherited property strips it from all the descendants.
+        /// Only optional properties are listed as the required ones can not be
+        /// stripped without breaking the instances.
+        /// </remarks>
+        public enum Property
+        {
+            SomethingCategory,
+            BlobValue,
+            BlobKind,
+            NoteWeight,
+            ContainerItems,
+            ContainerPinned,
+            TagSource
+        }  // public enum Property
+
+        /// <summary>
+        /// Decide which properties and instances are to be redacted.
+        /// </summary>
+        public interface IFilter
+        {
+            /// <summary>
+            /// Check whether <paramref name="property" /> of <paramref name="that" />
+            /// needs to be stripped.
+            /// </summary>
+            /// <remarks>
+            /// Only the properties which are set are checked.
+            /// </remarks>
+            bool Strips(Aas.IClass that, Property property);
+
+            /// <summary>
+            /// Check whether <paramref name="that" /> needs to be removed from
+            /// the list containing it.
+            /// </summary>
+            bool Excludes(Aas.IClass that);
+        }  // public interface IFilter
+
+        /// <summary>
+        /// Specify declaratively which properties and instances are to be redacted.
+        /// </summary>
+        public class Filter : IFilter
+        {
+            private readonly HashSet<Property> _properties;
+            private readonly System.Predicate<Aas.IClass>? _instances;
+
+            /// <param name="properties">Properties to be stripped</param>
+            /// <param name="instances">
+            /// If set, the instances in lists satisfying this predicate are removed
+            /// </param>
+            public Filter(
+                IEnumerable<Property> properties,
+                System.Predicate<Aas.IClass>? instances = null)
+            {
+                _properties = new HashSet<Property>(properties);
+                _instances = instances;
+            }
+
+            /// <inheritdoc />
+            public bool Strips(Aas.IClass that, Property property)
+            {
+                return _properties.Contains(property);
+            }
+
+            /// <inheritdoc />
+            public bool Excludes(Aas.IClass that)
+            {
+                return _instances != null && _instances(that);
+            }
+        }  // public class Filter
+
+        /// <summary>
+        /// Copy the instances while stripping the properties and removing the instances
+        /// as given by the filter.
+        /// </summary>
+        private class Redactor : Visitation.AbstractTransformer<Aas.IClass>
+        {
+            private readonly IFilter _filter;
+
+            public Redactor(IFilter filter)
+            {
+                _filter = filter;
+            }
+
+            private List<T> TransformList<T>(List<T> that) where T : Aas.IClass
+            {
+                var result = new List<T>(that.Count);
+                foreach (var item in that)
+                {
+                    if (!_filter.Excludes(item))
+                    {
+                        result.Add((T)Transform(item));
+                    }
+                }
+
+                return result;
+            }
+
+            public override Aas.IClass Transform(Aas.Blob that)
+            {
+                return new Aas.Blob(
+                    that.Id,
+                    that.Category != null && !_filter.Strips(that, Property.SomethingCategory)
+                        ? that.Category
+                        : null,
+                    that.Value != null && !_filter.Strips(that, Property.BlobValue)
+                        ? that.Value
+                        : null,
+                    that.Kind != null && !_filter.Strips(that, Property.BlobKind)
+                        ? that.Kind
+                        : null);
+            }
+
+            public override Aas.IClass Transform(Aas.Note that)
+            {
+                return new Aas.Note(
+                    that.Id,
+                    that.Text,
+                    that.Category != null && !_filter.Strips(that, Property.SomethingCategory)
+                        ? that.Category
+                        : null,
+                    that.Weight != null && !_filter.Strips(that, Property.NoteWeight)
+                        ? that.Weight
+                        : null);
+            }
+
+            public override Aas.IClass Transform(Aas.Container that)
+            {
+                return new Aas.Container(
+                    that.Count,
+                    that.Enabled,
+                    that.Items != null && !_filter.Strips(that, Property.ContainerItems)
+                        ? TransformList(that.Items)
+                        : null,
+                    that.Pinned != null && !_filter.Strips(that, Property.ContainerPinned)
+                        ? (Aas.Note)Transform(that.Pinned)
+                        : null);
+            }
+
+            public override Aas.IClass Transform(Aas.Tag that)
+            {
+                return new Aas.Tag(
+                    that.Label,
+                    that.Source != null && !_filter.Strips(that, Property.TagSource)
+                        ? that.Source
+                        : null);
+            }
+        }  // private class Redactor
+
+        /// <summary>
+        /// Make a copy of <paramref name="that" /> and all its descendants redacted
+        /// according to <paramref name="filter" />.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The original instances are left untouched. The values of the primitive
+        /// properties, including the byte arrays, are shared with the copy.
+        /// </para>
+        /// <para>
+        /// The redacted instances are not necessarily valid anymore. For example,
+        /// removing all the items of a required list breaks the constraint that
+        /// the list must not be empty. Please re-verify the result if needed.
+        /// </para>
+        /// </remarks>
+        public static T Redact<T>(T that, IFilter filter) where T : Aas.IClass
+        {
+            var redactor = new Redactor(filter);
+            return (T)redactor.Transform(that);
+        }
+    }  // public static class Redaction
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,268 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using Nodes = System.Text.Json.Nodes;
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Sign and verify model instances to protect their integrity.
+    /// </summary>
+    public static class Signing
+    {
+        /// <summary>
+        /// Contain a detached signature of a model instance.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The signature is computed over the SHA-256 hash of
+        /// <see cref="Digestion.CanonicalJson" />, so that it can be verified
+        /// regardless of how the instance has been serialized in the meantime.
+        /// </para>
+        /// <para>
+        /// Use <see cref="Signing.ToJsonObject" /> and
+        /// <see cref="Signing.SignatureFrom" /> to
+        /// transmit the signature alongside the instance.
+        /// </para>
+        /// </remarks>
+        public class Signature
+        {
+            /// <summary>
+            /// Identifier of the signature algorithm such as <c>ES256</c>
+            /// </summary>
+            public string Algorithm { get; }
+
+            /// <summary>
+            /// Identifier of the hash algorithm applied to the canonical form
+            /// </summary>
+            /// <remarks>
+            /// Only <c>SHA-256</c> is supported for verification. Other values can
+            /// come from signatures deserialized from other producers.
+            /// </remarks>
+            public string HashAlgorithm { get; }
+
+            /// <summary>
+            /// Identifier of the signing key, if any
+            /// </summary>
+            public string? KeyId { get; }
+
+            /// <summary>
+            /// Value of the signature
+            /// </summary>
+            public byte[] Value { get; }
+
+            public Signature(
+                string algorithm,
+                byte[] value,
+                string? keyId = null,
+                string hashAlgorithm = "SHA-256")
+            {
+                Algorithm = algorithm;
+                HashAlgorithm = hashAlgorithm;
+                Value = value;
+                KeyId = keyId;
+            }
+        }
+
+        /// <summary>
+        /// Compute the SHA-256 hash of the canonical form of <paramref name="that" />.
+        /// </summary>
+        public static byte[] ComputeHash(Aas.IClass that)
+        {
+            byte[] canonical = Digestion.CanonicalJson(that);
+
+            using var sha256 = System.Security.Cryptography.SHA256.Create();
+            return sha256.ComputeHash(canonical);
+        }
+
+        /// <summary>
+        /// Sign <paramref name="that" /> with a custom <paramref name="signHash" />.
+        /// </summary>
+        /// <remarks>
+        /// Use this overload to plug in a hardware security module or
+        /// a remote signing service.
+        /// </remarks>
+        /// <param name="that">instance to be signed</param>
+        /// <param name="algorithm">identifier of the signature algorithm</param>
+        /// <param name="signHash">sign the given hash and return the signature</param>
+        /// <param name="keyId">identifier of the signing key, if any</param>
+        public static Signature Sign(
+            Aas.IClass that,
+            string algorithm,
+            System.Func<byte[], byte[]> signHash,
+            string? keyId = null)
+        {
+            return new Signature(algorithm, signHash(ComputeHash(that)), keyId);
+        }
+
+        /// <summary>
+        /// Sign <paramref name="that" /> with the elliptic-curve <paramref name="key" />.
+        /// </summary>
+        /// <remarks>
+        /// Only the keys on the curve P-256 are supported as the signature algorithm
+        /// <c>ES256</c> prescribes SHA-256 which we use to hash the canonical form.
+        /// </remarks>
+        /// <exception cref="System.ArgumentException">
+        /// Thrown if the <paramref name="key" /> is not on the curve P-256.
+        /// </exception>
+        public static Signature Sign(
+            Aas.IClass that,
+            System.Security.Cryptography.ECDsa key,
+            string? keyId = null)
+        {
+            if (key.KeySize != 256)
+            {
+                throw new System.ArgumentException(
+                    "Expected a key on the curve P-256 for the algorithm ES256, " +
+                    $"but got a key of size {key.KeySize}",
+                    nameof(key));
+            }
+
+            return Sign(that, "ES256", key.SignHash, keyId);
+        }
+
+        /// <summary>
+        /// Verify the <paramref name="signature" /> of <paramref name="that" />
+        /// with a custom <paramref name="verifyHash" />.
+        /// </summary>
+        /// <param name="that">signed instance</param>
+        /// <param name="signature">detached signature of the instance</param>
+        /// <param name="verifyHash">
+        /// check the signature value (second argument) against the hash (first argument)
+        /// </param>
+        /// <returns>true if the signature is valid</returns>
+        public static bool Verify(
+            Aas.IClass that,
+            Signature signature,
+            System.Func<byte[], byte[], bool> verifyHash)
+        {
+            if (signature.HashAlgorithm != "SHA-256")
+            {
+                return false;
+            }
+
+            return verifyHash(ComputeHash(that), signature.Value);
+        }
+
+        /// <summary>
+        /// Verify the <paramref name="signature" /> of <paramref name="that" />
+        /// with the elliptic-curve <paramref name="key" />.
+        /// </summary>
+        /// <remarks>
+        /// Only the keys on the curve P-256 are supported, see
+        /// <see cref="Sign(Aas.IClass, System.Security.Cryptography.ECDsa, string?)" />.
+        /// </remarks>
+        /// <returns>true if the signature is valid</returns>
+        public static bool Verify(
+            Aas.IClass that,
+            Signature signature,
+            System.Security.Cryptography.ECDsa key)
+        {
+            if (signature.Algorithm != "ES256" || key.KeySize != 256)
+            {
+                return false;
+            }
+
+            return Verify(that, signature, key.VerifyHash);
+        }
+
+        /// <summary>
+        /// Serialize <paramref name="signature" /> to a JSON object.
+        /// </summary>
+        /// <remarks>
+        /// The value is encoded in Base-64, and the key ID is omitted if not set.
+        /// </remarks>
+        public static Nodes.JsonObject ToJsonObject(Signature signature)
+        {
+            var result = new Nodes.JsonObject();
+
+            result["algorithm"] = Nodes.JsonValue.Create(signature.Algorithm);
+            result["hashAlgorithm"] = Nodes.JsonValue.Create(signature.HashAlgorithm);
+
+            if (signature.KeyId != null)
+            {
+                result["keyId"] = Nodes.JsonValue.Create(signature.KeyId);
+            }
+
+            result["value"] = Nodes.JsonValue.Create(
+                System.Convert.ToBase64String(signature.Value));
+
+            return result;
+        }
+
+        private delegate T? PropertyDeserializer<T>(
+            Nodes.JsonNode node,
+            out Reporting.Error? error)
+            where T : class;
+
+        /// <summary>
+        /// Deserialize a property of <paramref name="obj" /> with
+        /// the deserialization function <paramref name="deserialize" />.
+        /// </summary>
+        /// <exception cref="Jsonization.Exception">
+        /// Thrown when the property is missing or invalid.
+        /// </exception>
+        private static T PropertyFrom<T>(
+            Nodes.JsonObject obj,
+            string name,
+            PropertyDeserializer<T> deserialize)
+            where T : class
+        {
+            Nodes.JsonNode node = obj[name]
+                ?? throw new Jsonization.Exception(
+                    "",
+                    $"Required property \"{name}\" is missing");
+
+            T? result = deserialize(node, out Reporting.Error? error);
+            if (error != null)
+            {
+                throw new Jsonization.Exception(name, error.Cause);
+            }
+
+            return result
+                ?? throw new System.InvalidOperationException(
+                    $"Unexpected {name} null when error is also null");
+        }
+
+        /// <summary>
+        /// Deserialize a signature from <paramref name="node" />.
+        /// </summary>
+        /// <param name="node">JSON node to be parsed</param>
+        /// <exception cref="Jsonization.Exception">
+        /// Thrown when <paramref name="node" /> is not a valid JSON
+        /// representation of a signature.
+        /// </exception>
+        public static Signature SignatureFrom(Nodes.JsonNode node)
+        {
+            Nodes.JsonObject? obj = node as Nodes.JsonObject;
+            if (obj == null)
+            {
+                throw new Jsonization.Exception(
+                    "",
+                    $"Expected a JsonObject, but got {node.GetType()}");
+            }
+
+            string algorithm = PropertyFrom<string>(
+                obj, "algorithm", Jsonization.DeserializeImplementation.StringFrom);
+            string hashAlgorithm = PropertyFrom<string>(
+                obj, "hashAlgorithm", Jsonization.DeserializeImplementation.StringFrom);
+            string? keyId = obj["keyId"] != null
+                ? PropertyFrom<string>(
+                    obj, "keyId", Jsonization.DeserializeImplementation.StringFrom)
+                : null;
+            byte[] value = PropertyFrom<byte[]>(
+                obj, "value", Jsonization.DeserializeImplementation.BytesFrom);
+
+            return new Signature(algorithm, value, keyId, hashAlgorithm);
+        }
+    }  // public static class Signing
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,141 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Compute size statistics over the model instances.
+    /// </summary>
+    public static class Statistics
+    {
+        /// <summary>
+        /// Capture the size statistics of a model instance and its descendants.
+        /// </summary>
+        public class Stats
+        {
+            /// <summary>
+            /// Number of instances by the name of their class
+            /// </summary>
+            public readonly Dictionary<string, long> CountByClass = (
+                new Dictionary<string, long>());
+
+            /// <summary>
+            /// Total number of instances
+            /// </summary>
+            public long Count;
+
+            /// <summary>
+            /// Total number of characters over all the string properties
+            /// </summary>
+            public long StringPayload;
+
+            /// <summary>
+            /// Total number of bytes over all the byte-array properties
+            /// </summary>
+            public long BytePayload;
+
+            /// <summary>
+            /// Maximum depth of nesting, where the root instance is at depth 1
+            /// </summary>
+            public int MaxDepth;
+
+            internal void CountInstance(string className)
+            {
+                Count++;
+                CountByClass.TryGetValue(className, out long count);
+                CountByClass[className] = count + 1;
+            }
+        }  // public class Stats
+
+        /// <summary>
+        /// Accumulate the statistics while descending through the instances.
+        /// </summary>
+        private class Collector : Visitation.VisitorThrough
+        {
+            private readonly Stats _stats;
+
+            private int _depth;
+
+            public Collector(Stats stats)
+            {
+                _stats = stats;
+            }
+
+            public override void Visit(Aas.IClass that)
+            {
+                _depth++;
+                if (_depth > _stats.MaxDepth)
+                {
+                    _stats.MaxDepth = _depth;
+                }
+
+                base.Visit(that);
+
+                _depth--;
+            }
+
+            public override void Visit(Aas.Blob that)
+            {
+                _stats.CountInstance("Blob");
+                _stats.StringPayload += that.Id.Length;
+                _stats.StringPayload += that.Category?.Length ?? 0;
+                _stats.BytePayload += that.Value?.Length ?? 0;
+
+                base.Visit(that);
+            }
+
+            public override void Visit(Aas.Note that)
+            {
+                _stats.CountInstance("Note");
+                _stats.StringPayload += that.Id.Length;
+                _stats.StringPayload += that.Category?.Length ?? 0;
+                _stats.StringPayload += that.Text.Length;
+
+                base.Visit(that);
+            }
+
+            public override void Visit(Aas.Container that)
+            {
+                _stats.CountInstance("Container");
+
+                base.Visit(that);
+            }
+
+            public override void Visit(Aas.Tag that)
+            {
+                _stats.CountInstance("Tag");
+                _stats.StringPayload += that.Label.Length;
+                _stats.StringPayload += that.Source?.Length ?? 0;
+
+                base.Visit(that);
+            }
+        }  // private class Collector
+
+        /// <summary>
+        /// Compute the size statistics over <paramref name="that" />
+        /// and all its descendants.
+        /// </summary>
+        /// <remarks>
+        /// This is useful for capacity planning and for diagnosing pathological
+        /// inputs.
+        /// </remarks>
+        public static Stats Compute(Aas.IClass that)
+        {
+            var stats = new Stats();
+            var collector = new Collector(stats);
+            collector.Visit(that);
+            return stats;
+        }
+    }  // public static class Statistics
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,72 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    public static class Stringification
+    {
+        private static readonly Dictionary<Aas.Kind, string> KindToString = (
+            new Dictionary<Aas.Kind, string>()
+            {
+                { Aas.Kind.Template, "Template" },
+                { Aas.Kind.Instance, "Instance" }
+            });
+
+        /// <summary>
+        /// Retrieve the string representation of <paramref name="that" />.
+        /// </summary>
+        /// <remarks>
+        /// If <paramref name="that" /> is not a valid literal, return <c>null</c>.
+        /// </remarks>
+        public static string? ToString(Aas.Kind? that)
+        {
+            if (!that.HasValue)
+            {
+                return null;
+            }
+            else
+            {
+                if (KindToString.TryGetValue(that.Value, out string? value))
+                {
+                    return value;
+                }
+                else
+                {
+                    return null;
+                }
+            }
+        }
+
+        /// <summary>
+        /// Parse the string representation of <see cref="Kind" />.
+        /// </summary>
+        /// <remarks>
+        /// If <paramref name="text" /> is not a valid string representation
+        /// of a literal of <see cref="Kind" />,
+        /// return <c>null</c>.
+        /// </remarks>
+        public static Aas.Kind? KindFromString(string text)
+        {
+            switch (text)
+            {
+                case "Template":
+                    return Aas.Kind.Template;
+                case "Instance":
+                    return Aas.Kind.Instance;
+                default:
+                    return null;
+            }
+        }
+    }  // public static class Stringification
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,354 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using CodeAnalysis = System.Diagnostics.CodeAnalysis;
+using Regex = System.Text.RegularExpressions.Regex;
+using System.Collections.Generic;  // can't alias
+using System.Linq;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Verify that the instances of the meta-model satisfy the invariants.
+    /// </summary>
+    /// <example>
+    /// Here is an example how to verify an instance of ISomething:
+    /// <code>
+    /// var anInstance = new Aas.ISomething(
+    ///     // ... some constructor arguments ...
+    /// );
+    /// foreach (var error in Verification.Verify(anInstance))
+    /// {
+    ///     System.Console.Writeln(
+    ///         $"{error.Cause} at: " +
+    ///         Reporting.GenerateJsonPath(error.PathSegments));
+    /// }
+    /// </code>
+    /// </example>
+    public static class Verification
+    {
+        /// <summary>
+        /// Check that <paramref name="text" /> is a valid absolute IRI.
+        /// </summary>
+        /// <remarks>
+        /// We delegate to <see cref="IriValidation.IsValid" /> instead of
+        /// the regular expression so that the percent-encoding is checked as well.
+        /// </remarks>
+        public static bool MatchesIri(string text)
+        {
+            return IriValidation.IsValid(text, IriValidation.Strictness.Strict);
+        }
+
+        /// <summary>
+        /// Hash allowed enum values for efficient validation of enums.
+        /// </summary>
+        internal static class EnumValueSet
+        {
+            internal static readonly HashSet<int> ForKind = new HashSet<int>
+            {
+
+                (int)Aas.Kind.Template,
+                (int)Aas.Kind.Instance
+            };
+        }  // internal static class EnumValueSet
+
+        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
+        private static readonly Verification.Transformer _transformer = (
+            new Verification.Transformer());
+
+        private class Transformer
+            : Visitation.AbstractTransformer<IEnumerable<Reporting.Error>>
+        {
+            private readonly Cache? _cache;
+
+            internal Transformer(Cache? cache = null)
+            {
+                _cache = cache;
+            }
+
+            /// <summary>
+            /// Verify <paramref name="that" />, and re-use the results from the cache,
+            /// if any.
+            /// </summary>
+            internal IEnumerable<Reporting.Error> VerifyChild(Aas.IClass that)
+            {
+                if (_cache == null)
+                {
+                    return Transform(that);
+                }
+
+                if (!_cache.Errors.TryGetValue(that, out List<Reporting.Error>? errors))
+                {
+                    errors = new List<Reporting.Error>(Transform(that));
+                    _cache.Errors.AddOrUpdate(that, errors);
+                }
+
+                return CopyErrors(errors);
+            }
+
+            /// <summary>
+            /// Copy the cached <paramref name="errors" /> since the callers prepend
+            /// their path segments to them.
+            /// </summary>
+            private static IEnumerable<Reporting.Error> CopyErrors(
+                List<Reporting.Error> errors)
+            {
+                foreach (var error in errors)
+                {
+                    var copy = new Reporting.Error(error.Cause);
+                    foreach (var segment in error.PathSegments.Reverse())
+                    {
+                        copy.PrependSegment(segment);
+                    }
+
+                    yield return copy;
+                }
+            }
+
+            [CodeAnalysis.SuppressMessage("ReSharper", "NegativeEqualityExpression")]
+            public override IEnumerable<Reporting.Error> Transform(
+                Aas.Blob that)
+            {
+                if (that.Kind != null)
+                {
+                    // We need to help the static analyzer with a null coalescing.
+                    Aas.Kind value = that.Kind
+                        ?? throw new System.InvalidOperationException();
+                    foreach (var error in Verification.VerifyKind(value))
+                    {
+                        error.PrependSegment(
+                            new Reporting.NameSegment(
+                                "kind"));
+                        yield return error;
+                    }
+                }
+            }
+
+            [CodeAnalysis.SuppressMessage("ReSharper", "NegativeEqualityExpression")]
+            public override IEnumerable<Reporting.Error> Transform(
+                Aas.Note that)
+            {
+                // No verification has been defined for Note.
+                yield break;
+            }
+
+            [CodeAnalysis.SuppressMessage("ReSharper", "NegativeEqualityExpression")]
+            public override IEnumerable<Reporting.Error> Transform(
+                Aas.Container that)
+            {
+                if (!(that.Count >= 0))
+                {
+                    yield return new Reporting.Error(
+                        "Invariant violated:\n" +
+                        "Count must be non-negative.\n" +
+                        "that.Count >= 0");
+                }
+
+                if (that.Items != null)
+                {
+                    int indexItems = 0;
+                    foreach (var item in that.Items)
+                    {
+                        foreach (var error in VerifyChild(item))
+                        {
+                            error.PrependSegment(
+                                new Reporting.IndexSegment(
+                                    indexItems));
+                            error.PrependSegment(
+                                new Reporting.NameSegment(
+                                    "items"));
+                            yield return error;
+                        }
+                        indexItems++;
+                    }
+                }
+
+                if (that.Pinned != null)
+                {
+                    foreach (var error in VerifyChild(that.Pinned))
+                    {
+                        error.PrependSegment(
+                            new Reporting.NameSegment(
+                                "pinned"));
+                        yield return error;
+                    }
+                }
+            }
+
+            [CodeAnalysis.SuppressMessage("ReSharper", "NegativeEqualityExpression")]
+            public override IEnumerable<Reporting.Error> Transform(
+                Aas.Tag that)
+            {
+                foreach (var error in Verification.VerifyShortString(that.Label))
+                {
+                    error.PrependSegment(
+                        new Reporting.NameSegment(
+                            "label"));
+                    yield return error;
+                }
+
+                if (that.Source != null)
+                {
+                    foreach (var error in Verification.VerifyIri(that.Source))
+                    {
+                        error.PrependSegment(
+                            new Reporting.NameSegment(
+                                "source"));
+                        yield return error;
+                    }
+                }
+            }
+        }  // private class Transformer
+
+        /// <summary>
+        /// Memoize the verification results of the instances which did not change.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The instances are keyed by their identity and held weakly so that
+        /// the cache does not keep them alive.
+        /// </para>
+        /// <para>
+        /// The cache does <em>not</em> observe the modifications of the instances.
+        /// The results of a modified instance and all its ancestors are stale until
+        /// you <see cref="Invalidate" /> each one of them.
+        /// </para>
+        /// <para>
+        /// The cache can be shared between the threads.
+        /// </para>
+        /// </remarks>
+        public class Cache
+        {
+            internal readonly System.Runtime.CompilerServices.ConditionalWeakTable<
+                Aas.IClass, List<Reporting.Error>> Errors = new();
+
+            /// <summary>
+            /// Forget the verification results of <paramref name="that" />.
+            /// </summary>
+            public void Invalidate(Aas.IClass that)
+            {
+                Errors.Remove(that);
+            }
+        }  // public class Cache
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" /> recursively.
+        /// </summary>
+        /// <param name="that">
+        /// The instance of the meta-model to be verified
+        /// </param>
+        public static IEnumerable<Reporting.Error> Verify(Aas.IClass that)
+        {
+            foreach (var error in _transformer.Transform(that))
+            {
+                yield return error;
+            }
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" /> recursively, and
+        /// re-use the results of the unchanged descendants from <paramref name="cache" />.
+        /// </summary>
+        /// <remarks>
+        /// The results of the modified instances are stale unless you invalidate them,
+        /// see <see cref="Cache" />.
+        /// </remarks>
+        public static List<Reporting.Error> Verify(Aas.IClass that, Cache cache)
+        {
+            return new List<Reporting.Error>(
+                new Verification.Transformer(cache).VerifyChild(that));
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="instances" /> recursively
+        /// and concurrently.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// Use this function to verify large collections on multi-core machines,
+        /// e.g., all the instances de-serialized from a large file.
+        /// </para>
+        /// <para>
+        /// The errors are merged deterministically in the order of
+        /// <paramref name="instances" />, and the index of the instance is prepended
+        /// to the path of each error.
+        /// </para>
+        /// </remarks>
+        public static List<Reporting.Error> VerifyInParallel(
+            IReadOnlyList<Aas.IClass> instances)
+        {
+            var errorsByIndex = new List<Reporting.Error>[instances.Count];
+
+            System.Threading.Tasks.Parallel.For(
+                0,
+                instances.Count,
+                i => errorsByIndex[i] = new List<Reporting.Error>(
+                    Verify(instances[i])));
+
+            var result = new List<Reporting.Error>();
+            for (int i = 0; i < errorsByIndex.Length; i++)
+            {
+                foreach (var error in errorsByIndex[i])
+                {
+                    error.PrependSegment(new Reporting.IndexSegment(i));
+                    result.Add(error);
+                }
+            }
+
+            return result;
+        }
+
+        /// <summary>
+        /// Verify that <paramref name="that" /> is a valid enumeration value.
+        /// </summary>
+        public static IEnumerable<Reporting.Error> VerifyKind(
+            Aas.Kind that)
+        {
+            if (!EnumValueSet.ForKind.Contains(
+                (int)that))
+            {
+                yield return new Reporting.Error(
+                    $"Invalid Kind: {that}");
+            }
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" />.
+        /// </summary>
+        public static IEnumerable<Reporting.Error> VerifyShortString (
+            string that)
+        {
+            if (!(that.Length <= 3))
+            {
+                yield return new Reporting.Error(
+                    "Invariant violated:\n" +
+                    "Short strings must not exceed 3 characters.\n" +
+                    "that.Length <= 3");
+            }
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" />.
+        /// </summary>
+        public static IEnumerable<Reporting.Error> VerifyIri (
+            string that)
+        {
+            if (!Verification.MatchesIri(that))
+            {
+                yield return new Reporting.Error(
+                    "Invariant violated:\n" +
+                    "The value must be an IRI.\n" +
+                    "Verification.MatchesIri(that)");
+            }
+        }
+    }  // public static class Verification
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,194 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+namespace Dummy
+{
+    public static class Visitation
+    {
+        /// <summary>
+        /// Define the interface for a visitor which visits the instances of the model.
+        /// </summary>
+        public interface IVisitor
+        {
+            public void Visit(IClass that);
+            public void Visit(Blob that);
+            public void Visit(Note that);
+            public void Visit(Container that);
+            public void Visit(Tag that);
+        }  // public interface IVisitor
+
+        /// <summary>
+        /// Just descend through the instances without any action.
+        /// </summary>
+        /// <remarks>
+        /// This class is meaningless for itself. However, it is a good base if you
+        /// want to descend through instances and apply actions only on a subset of
+        /// classes.
+        /// </remarks>
+        public class VisitorThrough : IVisitor
+        {
+            public virtual void Visit(IClass that)
+            {
+                that.Accept(this);
+            }
+
+            public virtual void Visit(Blob that)
+            {
+                // Just descend through, do nothing with <c>that</c>
+            }
+
+            public virtual void Visit(Note that)
+            {
+                // Just descend through, do nothing with <c>that</c>
+            }
+
+            public virtual void Visit(Container that)
+            {
+                // Just descend through, do nothing with <c>that</c>
+                if (that.Items != null)
+                {
+                    foreach (var anItem in that.Items)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Pinned != null)
+                {
+                    Visit((IClass)that.Pinned);
+                }
+            }
+
+            public virtual void Visit(Tag that)
+            {
+                // Just descend through, do nothing with <c>that</c>
+            }
+        }  // public class VisitorThrough
+
+        /// <summary>
+        /// Perform double-dispatch to visit the concrete instances.
+        /// </summary>
+        public abstract class AbstractVisitor : IVisitor
+        {
+            public virtual void Visit(IClass that)
+            {
+                that.Accept(this);
+            }
+            public abstract void Visit(Blob that);
+            public abstract void Visit(Note that);
+            public abstract void Visit(Container that);
+            public abstract void Visit(Tag that);
+        }  // public abstract class AbstractVisitor
+
+        /// <summary>
+        /// Define the interface for a visitor which visits the instances of the model.
+        /// </summary>
+        /// <typeparam name="TContext">Context type</typeparam>
+        public interface IVisitorWithContext<in TContext>
+        {
+            public void Visit(IClass that, TContext context);
+            public void Visit(Blob that, TContext context);
+            public void Visit(Note that, TContext context);
+            public void Visit(Container that, TContext context);
+            public void Visit(Tag that, TContext context);
+        }  // public interface IVisitorWithContext
+
+        /// <summary>
+        /// Perform double-dispatch to visit the concrete instances
+        /// with context.
+        /// </summary>
+        /// <typeparam name="TContext">Context type</typeparam>
+        public abstract class AbstractVisitorWithContext<TContext>
+            : IVisitorWithContext<TContext>
+        {
+            public void Visit(IClass that, TContext context)
+            {
+                that.Accept(this, context);
+            }
+            public abstract void Visit(Blob that, TContext context);
+            public abstract void Visit(Note that, TContext context);
+            public abstract void Visit(Container that, TContext context);
+            public abstract void Visit(Tag that, TContext context);
+        }  // public abstract class AbstractVisitorWithContext
+
+        /// <summary>
+        /// Define the interface for a transformer which transforms recursively
+        /// the instances into something else.
+        /// </summary>
+        /// <typeparam name="T">The type of the transformation result</typeparam>
+        public interface ITransformer<out T>
+        {
+            public T Transform(IClass that);
+            public T Transform(Blob that);
+            public T Transform(Note that);
+            public T Transform(Container that);
+            public T Transform(Tag that);
+        }  // public interface ITransformer
+
+        /// <summary>
+        /// Perform double-dispatch to transform recursively
+        /// the instances into something else.
+        /// </summary>
+        /// <typeparam name="T">The type of the transformation result</typeparam>
+        public abstract class AbstractTransformer<T> : ITransformer<T>
+        {
+            public T Transform(IClass that)
+            {
+                return that.Transform(this);
+            }
+
+            public abstract T Transform(Blob that);
+
+            public abstract T Transform(Note that);
+
+            public abstract T Transform(Container that);
+
+            public abstract T Transform(Tag that);
+        }  // public abstract class AbstractTransformer
+
+        /// <summary>
+        /// Define the interface for a transformer which recursively transforms
+        /// the instances into something else while the context is passed along.
+        /// </summary>
+        /// <typeparam name="TContext">Type of the transformation context</typeparam>
+        /// <typeparam name="T">The type of the transformation result</typeparam>
+        public interface ITransformerWithContext<in TContext, out T>
+        {
+            public T Transform(IClass that, TContext context);
+            public T Transform(Blob that, TContext context);
+            public T Transform(Note that, TContext context);
+            public T Transform(Container that, TContext context);
+            public T Transform(Tag that, TContext context);
+        }  // public interface ITransformerWithContext
+
+        /// <summary>
+        /// Perform double-dispatch to transform recursively
+        /// the instances into something else.
+        /// </summary>
+        /// <typeparam name="TContext">The type of the transformation context</typeparam>
+        /// <typeparam name="T">The type of the transformation result</typeparam>
+        public abstract class AbstractTransformerWithContext<TContext, T>
+            : ITransformerWithContext<TContext, T>
+        {
+            public T Transform(IClass that, TContext context)
+            {
+                return that.Transform(this, context);
+            }
+
+            public abstract T Transform(Blob that, TContext context);
+
+            public abstract T Transform(Note that, TContext context);
+
+            public abstract T Transform(Container that, TContext context);
+
+            public abstract T Transform(Tag that, TContext context);
+        }  // public abstract class AbstractTransformerWithContext
+    }  // public static class Visitation
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
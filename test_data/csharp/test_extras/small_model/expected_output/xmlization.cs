@@ -0,0 +1,2218 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using CodeAnalysis = System.Diagnostics.CodeAnalysis;
+using Xml = System.Xml;
+using System.Collections.Generic;  // can't alias
+
+using Aas = Dummy;
+
+namespace Dummy
+{
+    /// <summary>
+    /// Provide de/serialization of meta-model classes to/from XML.
+    /// </summary>
+    public static class Xmlization
+    {
+        /// <summary>
+        /// Implement the deserialization of meta-model classes from XML.
+        /// </summary>
+        /// <remarks>
+        /// The implementation propagates an <see cref="Reporting.Error" /> instead of
+        /// relying on exceptions. Under the assumption that incorrect data is much less
+        /// frequent than correct data, this makes the deserialization more
+        /// efficient.
+        ///
+        /// However, we do not want to force the client to deal with
+        /// the <see cref="Reporting.Error" /> class as this is not intuitive.
+        /// Therefore we distinguish the implementation, realized in
+        /// <see cref="DeserializeImplementation" />, and the facade given in
+        /// <see cref="Deserialize" /> class.
+        /// </remarks>
+        internal static class DeserializeImplementation
+        {
+            internal static void SkipNoneWhitespaceAndComments(
+                Xml.XmlReader reader)
+            {
+                while (
+                    !reader.EOF
+                    && (
+                        reader.NodeType == Xml.XmlNodeType.None
+                        || reader.NodeType == Xml.XmlNodeType.Whitespace
+                        || reader.NodeType == Xml.XmlNodeType.Comment))
+                {
+                    reader.Read();
+                }
+            }
+
+            /// <summary>
+            /// Read the whole content of an element into memory.
+            /// </summary>
+            private static byte[] ReadWholeContentAsBase64(
+                Xml.XmlReader reader)
+            {
+                // The capacity of 1024 bytes is an arbitrary,
+                // but plausible default capacity.
+                byte[] buffer = new byte[1024];
+                using System.IO.MemoryStream stream = (
+                    new System.IO.MemoryStream(1024));
+                int readBytes;
+                while ((readBytes = reader.ReadContentAsBase64(buffer, 0, 1024)) > 0)
+                {
+                    stream.Write(buffer, 0, readBytes);
+                }
+                return stream.ToArray();
+            }
+
+            /// <summary>
+            /// Read the content as a string and intern it with
+            /// <see cref="Deserialize.Intern" />, if set.
+            /// </summary>
+            private static string ReadContentAsInternedString(
+                Xml.XmlReader reader)
+            {
+                string result = reader.ReadContentAsString();
+                var intern = Deserialize.Intern;
+                return (intern != null)
+                    ? intern(result)
+                    : result;
+            }
+
+            /// <summary>
+            /// Check the namespace and extract the element's name.
+            /// </summary>
+            /// <remarks>
+            /// If the namespace has been specified, the prefix is automatically
+            /// taken care of by <see cref="Xml.XmlReader" />, and we return the local
+            /// name stripped of the prefix. Otherwise, we return the element's name as-is.
+            /// </remarks>
+            private static string TryElementName(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error
+                )
+            {
+                // Pre-condition
+                if (reader.NodeType != Xml.XmlNodeType.Element
+                    && reader.NodeType != Xml.XmlNodeType.EndElement)
+                {
+                    throw new System.InvalidOperationException(
+                        "Expected to be at a start or an end element " +
+                        $"in {nameof(TryElementName)}, " +
+                        $"but got: {reader.NodeType}");
+                }
+
+                error = null;
+                if (ns == null)
+                {
+                    return reader.Name;
+                }
+                else
+                {
+                    if (ns != reader.NamespaceURI)
+                    {
+                        error = new Reporting.Error(
+                            $"Expected an element within a namespace {ns}, " +
+                            $"but got: {reader.NamespaceURI}");
+                            return "";
+                    }
+                    return reader.LocalName;
+                }
+            }
+
+            /// <summary>
+            /// Deserialize an instance of ISomething from an XML element.
+            /// </summary>
+            [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
+            internal static Aas.ISomething? ISomethingFromElement(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (reader.EOF)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element, but reached end-of-file");
+                    return null;
+                }
+
+                if (reader.NodeType != Xml.XmlNodeType.Element)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element, " +
+                        $"but got a node of type {reader.NodeType} " +
+                        $"with value {reader.Value}");
+                    return null;
+                }
+
+                string elementName = TryElementName(
+                    reader, ns, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+
+                switch (elementName)
+                {
+                    case "blob":
+                        return BlobFromElement(
+                            reader, ns, out error);
+                    case "note":
+                        return NoteFromElement(
+                            reader, ns, out error);
+                    default:
+                        error = new Reporting.Error(
+                            $"Unexpected element with the name {elementName}");
+                        return null;
+                }
+            }  // internal static Aas.ISomething? ISomethingFromElement
+
+            /// <summary>
+            /// Deserialize an instance of class Blob from a sequence of XML elements.
+            /// </summary>
+            /// <remarks>
+            /// If <paramref name="isEmptySequence" /> is set, we should try to deserialize
+            /// the instance from an empty sequence. That is, the parent element
+            /// was a self-closing element.
+            /// </remarks>
+            internal static Aas.Blob? BlobFromSequence(
+                Xml.XmlReader reader,
+                bool isEmptySequence,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                string? theId = null;
+                string? theCategory = null;
+                byte[]? theValue = null;
+                Kind? theKind = null;
+
+                if (!isEmptySequence)
+                {
+                    SkipNoneWhitespaceAndComments(reader);
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML element representing " +
+                            "a property of an instance of class Blob, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+                    while (reader.NodeType == Xml.XmlNodeType.Element)
+                    {
+                        string elementName = TryElementName(
+                            reader, ns, out error);
+                        if (error != null)
+                        {
+                            return null;
+                        }
+
+                        bool isEmptyProperty = reader.IsEmptyElement;
+
+                        // Skip the expected element
+                        reader.Read();
+
+                        switch (elementName)
+                        {
+                            case "id":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theId = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Id of an instance of class Blob, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Id of an instance of class Blob " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "id"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "category":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theCategory = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Category of an instance of class Blob, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Category of an instance of class Blob " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "category"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "value":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Value of an instance of class Blob " +
+                                        "can not be de-serialized from a self-closing element " +
+                                        "since it needs content");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "value"));
+                                    return null;
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Value of an instance of class Blob, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theValue = DeserializeImplementation.ReadWholeContentAsBase64(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Value of an instance of class Blob " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "value"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "kind":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Kind of an instance of class Blob " +
+                                        "can not be de-serialized from a self-closing element " +
+                                        "since it needs content");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "kind"));
+                                    return null;
+                                }
+
+                                    if (reader.EOF)
+                                {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Kind of an instance of class Blob, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                }
+
+                                string textKind;
+                                try
+                                {
+                                    textKind = reader.ReadContentAsString();
+                                }
+                                catch (System.FormatException exception)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Kind of an instance of class Blob " +
+                                        $"could not be de-serialized as a string: {exception}");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "kind"));
+                                    return null;
+                                }
+
+                                theKind = Stringification.KindFromString(
+                                    textKind);
+
+                                if (theKind == null)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Kind of an instance of class Blob " +
+                                        "could not be de-serialized from an unexpected enumeration literal: " +
+                                        textKind);
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "kind"));
+                                    return null;
+                                }
+                                break;
+                            }
+                            default:
+                                error = new Reporting.Error(
+                                    "We expected properties of the class Blob, " +
+                                    "but got an unexpected element " +
+                                    $"with the name {elementName}");
+                                return null;
+                        }
+
+                        SkipNoneWhitespaceAndComments(reader);
+
+                        if (!isEmptyProperty)
+                        {
+                            // Read the end element
+
+                            if (reader.EOF)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Blob " +
+                                    $"with the element name {elementName}, " +
+                                    "but got the end-of-file.");
+                                return null;
+                            }
+                            if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Blob " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the node of type {reader.NodeType} " +
+                                    $"with the value {reader.Value}");
+                                return null;
+                            }
+
+                            string endElementName = TryElementName(
+                                reader, ns, out error);
+                            if (error != null)
+                            {
+                                return null;
+                            }
+
+                            if (endElementName != elementName)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Blob " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the end element with the name {reader.Name}");
+                                return null;
+                            }
+                            // Skip the expected end element
+                            reader.Read();
+
+                            SkipNoneWhitespaceAndComments(reader);
+                        }
+
+                        if (reader.EOF)
+                        {
+                            break;
+                        }
+                    }
+                }
+
+                if (theId == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Id has not been given " +
+                        "in the XML representation of an instance of class Blob");
+                    return null;
+                }
+
+                return new Aas.Blob(
+                    theId
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theCategory,
+                    theValue,
+                    theKind);
+            }  // internal static Aas.Blob? BlobFromSequence
+
+            /// <summary>
+            /// Deserialize an instance of class Blob from an XML element.
+            /// </summary>
+            internal static Aas.Blob? BlobFromElement(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (reader.EOF)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Blob, " +
+                        "but reached the end-of-file");
+                    return null;
+                }
+
+                if (reader.NodeType != Xml.XmlNodeType.Element)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Blob, " +
+                        $"but got a node of type {reader.NodeType} " +
+                        $"with value {reader.Value}");
+                    return null;
+                }
+
+                string elementName = TryElementName(
+                    reader, ns, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+
+                if (elementName != "blob")
+                {
+                    error = new Reporting.Error(
+                        "Expected an element representing an instance of class Blob " +
+                        $"with element name blob, but got: {elementName}");
+                    return null;
+                }
+
+                bool isEmptyElement = reader.IsEmptyElement;
+
+                // Skip the element node and go to the content
+                reader.Read();
+
+                Aas.Blob? result = (
+                    BlobFromSequence(
+                        reader, isEmptyElement, ns, out error));
+                if (error != null)
+                {
+                    return null;
+                }
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (!isEmptyElement)
+                {
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Blob, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+
+                    if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Blob, " +
+                            $"but got a node of type {reader.NodeType} " +
+                            $"with value {reader.Value}");
+                        return null;
+                    }
+
+                    string endElementName = TryElementName(
+                        reader, ns, out error);
+                    if (error != null)
+                    {
+                        return null;
+                    }
+
+                    if (endElementName != elementName)
+                    {
+                        error = new Reporting.Error(
+                            $"Expected an XML end element with an name {elementName}, " +
+                            $"but got: {endElementName}");
+                        return null;
+                    }
+
+                    // Skip the end element
+                    reader.Read();
+                }
+
+                return result;
+            }  // internal static Aas.Blob? BlobFromElement
+
+            /// <summary>
+            /// Deserialize an instance of class Note from a sequence of XML elements.
+            /// </summary>
+            /// <remarks>
+            /// If <paramref name="isEmptySequence" /> is set, we should try to deserialize
+            /// the instance from an empty sequence. That is, the parent element
+            /// was a self-closing element.
+            /// </remarks>
+            internal static Aas.Note? NoteFromSequence(
+                Xml.XmlReader reader,
+                bool isEmptySequence,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                string? theId = null;
+                string? theCategory = null;
+                string? theText = null;
+                double? theWeight = null;
+
+                if (!isEmptySequence)
+                {
+                    SkipNoneWhitespaceAndComments(reader);
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML element representing " +
+                            "a property of an instance of class Note, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+                    while (reader.NodeType == Xml.XmlNodeType.Element)
+                    {
+                        string elementName = TryElementName(
+                            reader, ns, out error);
+                        if (error != null)
+                        {
+                            return null;
+                        }
+
+                        bool isEmptyProperty = reader.IsEmptyElement;
+
+                        // Skip the expected element
+                        reader.Read();
+
+                        switch (elementName)
+                        {
+                            case "id":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theId = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Id of an instance of class Note, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Id of an instance of class Note " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "id"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "category":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theCategory = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Category of an instance of class Note, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Category of an instance of class Note " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "category"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "text":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theText = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Text of an instance of class Note, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theText = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Text of an instance of class Note " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "text"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "weight":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Weight of an instance of class Note " +
+                                        "can not be de-serialized from a self-closing element " +
+                                        "since it needs content");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "weight"));
+                                    return null;
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Weight of an instance of class Note, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theWeight = reader.ReadContentAsDouble();
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Weight of an instance of class Note " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "weight"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            default:
+                                error = new Reporting.Error(
+                                    "We expected properties of the class Note, " +
+                                    "but got an unexpected element " +
+                                    $"with the name {elementName}");
+                                return null;
+                        }
+
+                        SkipNoneWhitespaceAndComments(reader);
+
+                        if (!isEmptyProperty)
+                        {
+                            // Read the end element
+
+                            if (reader.EOF)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Note " +
+                                    $"with the element name {elementName}, " +
+                                    "but got the end-of-file.");
+                                return null;
+                            }
+                            if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Note " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the node of type {reader.NodeType} " +
+                                    $"with the value {reader.Value}");
+                                return null;
+                            }
+
+                            string endElementName = TryElementName(
+                                reader, ns, out error);
+                            if (error != null)
+                            {
+                                return null;
+                            }
+
+                            if (endElementName != elementName)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Note " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the end element with the name {reader.Name}");
+                                return null;
+                            }
+                            // Skip the expected end element
+                            reader.Read();
+
+                            SkipNoneWhitespaceAndComments(reader);
+                        }
+
+                        if (reader.EOF)
+                        {
+                            break;
+                        }
+                    }
+                }
+
+                if (theId == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Id has not been given " +
+                        "in the XML representation of an instance of class Note");
+                    return null;
+                }
+
+                if (theText == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Text has not been given " +
+                        "in the XML representation of an instance of class Note");
+                    return null;
+                }
+
+                return new Aas.Note(
+                    theId
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theText
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theCategory,
+                    theWeight);
+            }  // internal static Aas.Note? NoteFromSequence
+
+            /// <summary>
+            /// Deserialize an instance of class Note from an XML element.
+            /// </summary>
+            internal static Aas.Note? NoteFromElement(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (reader.EOF)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Note, " +
+                        "but reached the end-of-file");
+                    return null;
+                }
+
+                if (reader.NodeType != Xml.XmlNodeType.Element)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Note, " +
+                        $"but got a node of type {reader.NodeType} " +
+                        $"with value {reader.Value}");
+                    return null;
+                }
+
+                string elementName = TryElementName(
+                    reader, ns, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+
+                if (elementName != "note")
+                {
+                    error = new Reporting.Error(
+                        "Expected an element representing an instance of class Note " +
+                        $"with element name note, but got: {elementName}");
+                    return null;
+                }
+
+                bool isEmptyElement = reader.IsEmptyElement;
+
+                // Skip the element node and go to the content
+                reader.Read();
+
+                Aas.Note? result = (
+                    NoteFromSequence(
+                        reader, isEmptyElement, ns, out error));
+                if (error != null)
+                {
+                    return null;
+                }
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (!isEmptyElement)
+                {
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Note, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+
+                    if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Note, " +
+                            $"but got a node of type {reader.NodeType} " +
+                            $"with value {reader.Value}");
+                        return null;
+                    }
+
+                    string endElementName = TryElementName(
+                        reader, ns, out error);
+                    if (error != null)
+                    {
+                        return null;
+                    }
+
+                    if (endElementName != elementName)
+                    {
+                        error = new Reporting.Error(
+                            $"Expected an XML end element with an name {elementName}, " +
+                            $"but got: {endElementName}");
+                        return null;
+                    }
+
+                    // Skip the end element
+                    reader.Read();
+                }
+
+                return result;
+            }  // internal static Aas.Note? NoteFromElement
+
+            /// <summary>
+            /// Deserialize an instance of class Container from a sequence of XML elements.
+            /// </summary>
+            /// <remarks>
+            /// If <paramref name="isEmptySequence" /> is set, we should try to deserialize
+            /// the instance from an empty sequence. That is, the parent element
+            /// was a self-closing element.
+            /// </remarks>
+            internal static Aas.Container? ContainerFromSequence(
+                Xml.XmlReader reader,
+                bool isEmptySequence,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                List<ISomething>? theItems = null;
+                Note? thePinned = null;
+                long? theCount = null;
+                bool? theEnabled = null;
+
+                if (!isEmptySequence)
+                {
+                    SkipNoneWhitespaceAndComments(reader);
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML element representing " +
+                            "a property of an instance of class Container, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+                    while (reader.NodeType == Xml.XmlNodeType.Element)
+                    {
+                        string elementName = TryElementName(
+                            reader, ns, out error);
+                        if (error != null)
+                        {
+                            return null;
+                        }
+
+                        bool isEmptyProperty = reader.IsEmptyElement;
+
+                        // Skip the expected element
+                        reader.Read();
+
+                        switch (elementName)
+                        {
+                            case "items":
+                            {
+                                theItems = new List<ISomething>();
+
+                                if (!isEmptyProperty)
+                                {
+                                    SkipNoneWhitespaceAndComments(reader);
+
+                                    int indexItems = 0;
+                                    while (reader.NodeType == Xml.XmlNodeType.Element)
+                                    {
+                                        ISomething? item = ISomethingFromElement(
+                                            reader, ns, out error);
+
+                                        if (error != null)
+                                        {
+                                            error.PrependSegment(
+                                                new Reporting.IndexSegment(
+                                                    indexItems));
+                                            return null;
+                                        }
+
+                                        theItems.Add(
+                                            item
+                                                ?? throw new System.InvalidOperationException(
+                                                    "Unexpected item null when error null"));
+
+                                        indexItems++;
+                                        SkipNoneWhitespaceAndComments(reader);
+                                    }
+                                }
+                                break;
+                            }
+                            case "pinned":
+                            {
+                                thePinned = NoteFromSequence(
+                                    reader, isEmptyProperty, ns, out error);
+
+                                if (error != null)
+                                {
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "pinned"));
+                                    return null;
+                                }
+                                break;
+                            }
+                            case "count":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Count of an instance of class Container " +
+                                        "can not be de-serialized from a self-closing element " +
+                                        "since it needs content");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "count"));
+                                    return null;
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Count of an instance of class Container, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theCount = reader.ReadContentAsLong();
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Count of an instance of class Container " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "count"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "enabled":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    error = new Reporting.Error(
+                                        "The property Enabled of an instance of class Container " +
+                                        "can not be de-serialized from a self-closing element " +
+                                        "since it needs content");
+                                    error.PrependSegment(
+                                        new Reporting.NameSegment(
+                                            "enabled"));
+                                    return null;
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Enabled of an instance of class Container, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theEnabled = reader.ReadContentAsBoolean();
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Enabled of an instance of class Container " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "enabled"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            default:
+                                error = new Reporting.Error(
+                                    "We expected properties of the class Container, " +
+                                    "but got an unexpected element " +
+                                    $"with the name {elementName}");
+                                return null;
+                        }
+
+                        SkipNoneWhitespaceAndComments(reader);
+
+                        if (!isEmptyProperty)
+                        {
+                            // Read the end element
+
+                            if (reader.EOF)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Container " +
+                                    $"with the element name {elementName}, " +
+                                    "but got the end-of-file.");
+                                return null;
+                            }
+                            if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Container " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the node of type {reader.NodeType} " +
+                                    $"with the value {reader.Value}");
+                                return null;
+                            }
+
+                            string endElementName = TryElementName(
+                                reader, ns, out error);
+                            if (error != null)
+                            {
+                                return null;
+                            }
+
+                            if (endElementName != elementName)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Container " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the end element with the name {reader.Name}");
+                                return null;
+                            }
+                            // Skip the expected end element
+                            reader.Read();
+
+                            SkipNoneWhitespaceAndComments(reader);
+                        }
+
+                        if (reader.EOF)
+                        {
+                            break;
+                        }
+                    }
+                }
+
+                if (theCount == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Count has not been given " +
+                        "in the XML representation of an instance of class Container");
+                    return null;
+                }
+
+                if (theEnabled == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Enabled has not been given " +
+                        "in the XML representation of an instance of class Container");
+                    return null;
+                }
+
+                return new Aas.Container(
+                    theCount
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theEnabled
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theItems,
+                    thePinned);
+            }  // internal static Aas.Container? ContainerFromSequence
+
+            /// <summary>
+            /// Deserialize an instance of class Container from an XML element.
+            /// </summary>
+            internal static Aas.Container? ContainerFromElement(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (reader.EOF)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Container, " +
+                        "but reached the end-of-file");
+                    return null;
+                }
+
+                if (reader.NodeType != Xml.XmlNodeType.Element)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Container, " +
+                        $"but got a node of type {reader.NodeType} " +
+                        $"with value {reader.Value}");
+                    return null;
+                }
+
+                string elementName = TryElementName(
+                    reader, ns, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+
+                if (elementName != "container")
+                {
+                    error = new Reporting.Error(
+                        "Expected an element representing an instance of class Container " +
+                        $"with element name container, but got: {elementName}");
+                    return null;
+                }
+
+                bool isEmptyElement = reader.IsEmptyElement;
+
+                // Skip the element node and go to the content
+                reader.Read();
+
+                Aas.Container? result = (
+                    ContainerFromSequence(
+                        reader, isEmptyElement, ns, out error));
+                if (error != null)
+                {
+                    return null;
+                }
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (!isEmptyElement)
+                {
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Container, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+
+                    if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Container, " +
+                            $"but got a node of type {reader.NodeType} " +
+                            $"with value {reader.Value}");
+                        return null;
+                    }
+
+                    string endElementName = TryElementName(
+                        reader, ns, out error);
+                    if (error != null)
+                    {
+                        return null;
+                    }
+
+                    if (endElementName != elementName)
+                    {
+                        error = new Reporting.Error(
+                            $"Expected an XML end element with an name {elementName}, " +
+                            $"but got: {endElementName}");
+                        return null;
+                    }
+
+                    // Skip the end element
+                    reader.Read();
+                }
+
+                return result;
+            }  // internal static Aas.Container? ContainerFromElement
+
+            /// <summary>
+            /// Deserialize an instance of class Tag from a sequence of XML elements.
+            /// </summary>
+            /// <remarks>
+            /// If <paramref name="isEmptySequence" /> is set, we should try to deserialize
+            /// the instance from an empty sequence. That is, the parent element
+            /// was a self-closing element.
+            /// </remarks>
+            internal static Aas.Tag? TagFromSequence(
+                Xml.XmlReader reader,
+                bool isEmptySequence,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                string? theLabel = null;
+                string? theSource = null;
+
+                if (!isEmptySequence)
+                {
+                    SkipNoneWhitespaceAndComments(reader);
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML element representing " +
+                            "a property of an instance of class Tag, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+                    while (reader.NodeType == Xml.XmlNodeType.Element)
+                    {
+                        string elementName = TryElementName(
+                            reader, ns, out error);
+                        if (error != null)
+                        {
+                            return null;
+                        }
+
+                        bool isEmptyProperty = reader.IsEmptyElement;
+
+                        // Skip the expected element
+                        reader.Read();
+
+                        switch (elementName)
+                        {
+                            case "label":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theLabel = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Label of an instance of class Tag, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theLabel = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Label of an instance of class Tag " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "label"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            case "source":
+                            {
+                                if (isEmptyProperty)
+                                {
+                                    theSource = "";
+                                }
+                                else
+                                {
+                                    if (reader.EOF)
+                                    {
+                                        error = new Reporting.Error(
+                                            "Expected an XML content representing " +
+                                            "the property Source of an instance of class Tag, " +
+                                            "but reached the end-of-file");
+                                        return null;
+                                    }
+
+                                    try
+                                    {
+                                        theSource = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
+                                    }
+                                    catch (System.Exception exception)
+                                    {
+                                        if (exception is System.FormatException
+                                            || exception is System.Xml.XmlException)
+                                        {
+                                            error = new Reporting.Error(
+                                                "The property Source of an instance of class Tag " +
+                                                $"could not be de-serialized: {exception.Message}");
+                                            error.PrependSegment(
+                                                new Reporting.NameSegment(
+                                                    "source"));
+                                            return null;
+                                        }
+
+                                        throw;
+                                    }
+                                }
+                                break;
+                            }
+                            default:
+                                error = new Reporting.Error(
+                                    "We expected properties of the class Tag, " +
+                                    "but got an unexpected element " +
+                                    $"with the name {elementName}");
+                                return null;
+                        }
+
+                        SkipNoneWhitespaceAndComments(reader);
+
+                        if (!isEmptyProperty)
+                        {
+                            // Read the end element
+
+                            if (reader.EOF)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Tag " +
+                                    $"with the element name {elementName}, " +
+                                    "but got the end-of-file.");
+                                return null;
+                            }
+                            if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Tag " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the node of type {reader.NodeType} " +
+                                    $"with the value {reader.Value}");
+                                return null;
+                            }
+
+                            string endElementName = TryElementName(
+                                reader, ns, out error);
+                            if (error != null)
+                            {
+                                return null;
+                            }
+
+                            if (endElementName != elementName)
+                            {
+                                error = new Reporting.Error(
+                                    "Expected an XML end element to conclude a property of class Tag " +
+                                    $"with the element name {elementName}, " +
+                                    $"but got the end element with the name {reader.Name}");
+                                return null;
+                            }
+                            // Skip the expected end element
+                            reader.Read();
+
+                            SkipNoneWhitespaceAndComments(reader);
+                        }
+
+                        if (reader.EOF)
+                        {
+                            break;
+                        }
+                    }
+                }
+
+                if (theLabel == null)
+                {
+                    error = new Reporting.Error(
+                        "The required property Label has not been given " +
+                        "in the XML representation of an instance of class Tag");
+                    return null;
+                }
+
+                return new Aas.Tag(
+                    theLabel
+                         ?? throw new System.InvalidOperationException(
+                            "Unexpected null, had to be handled before"),
+                    theSource);
+            }  // internal static Aas.Tag? TagFromSequence
+
+            /// <summary>
+            /// Deserialize an instance of class Tag from an XML element.
+            /// </summary>
+            internal static Aas.Tag? TagFromElement(
+                Xml.XmlReader reader,
+                string? ns,
+                out Reporting.Error? error)
+            {
+                error = null;
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (reader.EOF)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Tag, " +
+                        "but reached the end-of-file");
+                    return null;
+                }
+
+                if (reader.NodeType != Xml.XmlNodeType.Element)
+                {
+                    error = new Reporting.Error(
+                        "Expected an XML element representing an instance of class Tag, " +
+                        $"but got a node of type {reader.NodeType} " +
+                        $"with value {reader.Value}");
+                    return null;
+                }
+
+                string elementName = TryElementName(
+                    reader, ns, out error);
+                if (error != null)
+                {
+                    return null;
+                }
+
+                if (elementName != "tag")
+                {
+                    error = new Reporting.Error(
+                        "Expected an element representing an instance of class Tag " +
+                        $"with element name tag, but got: {elementName}");
+                    return null;
+                }
+
+                bool isEmptyElement = reader.IsEmptyElement;
+
+                // Skip the element node and go to the content
+                reader.Read();
+
+                Aas.Tag? result = (
+                    TagFromSequence(
+                        reader, isEmptyElement, ns, out error));
+                if (error != null)
+                {
+                    return null;
+                }
+
+                SkipNoneWhitespaceAndComments(reader);
+
+                if (!isEmptyElement)
+                {
+                    if (reader.EOF)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Tag, " +
+                            "but reached the end-of-file");
+                        return null;
+                    }
+
+                    if (reader.NodeType != Xml.XmlNodeType.EndElement)
+                    {
+                        error = new Reporting.Error(
+                            "Expected an XML end element concluding an instance of class Tag, " +
+                            $"but got a node of type {reader.NodeType} " +
+                            $"with value {reader.Value}");
+                        return null;
+                    }
+
+                    string endElementName = TryElementName(
+                        reader, ns, out error);
+                    if (error != null)
+                    {
+                        return null;
+                    }
+
+                    if (endElementName != elementName)
+                    {
+                        error = new Reporting.Error(
+                            $"Expected an XML end element with an name {elementName}, " +
+                            $"but got: {endElementName}");
+                        return null;
+                    }
+
+                    // Skip the end element
+                    reader.Read();
+                }
+
+                return result;
+            }  // internal static Aas.Tag? TagFromElement
+        }  // internal static class DeserializeImplementation
+
+        /// <summary>
+        /// Represent a critical error during the deserialization.
+        /// </summary>
+        public class Exception : System.Exception
+        {
+            public readonly string Path;
+            public readonly string Cause;
+            public Exception(string path, string cause)
+                : base($"{cause} at: {path}")
+            {
+                Path = path;
+                Cause = cause;
+            }
+        }
+
+        /// <summary>
+        /// Deserialize instances of meta-model classes from XML.
+        /// </summary>
+        /// <example>
+        /// Here is an example how to parse an instance of class ISomething:
+        /// <code>
+        /// var reader = new System.Xml.XmlReader(/* some arguments */);
+        /// Aas.ISomething anInstance = Deserialize.ISomethingFrom(
+        ///     reader);
+        /// </code>
+        /// </example>
+        ///
+        /// <example>
+        /// If the elements live in a namespace, you have to supply it. For example:
+        /// <code>
+        /// var reader = new System.Xml.XmlReader(/* some arguments */);
+        /// Aas.ISomething anInstance = Deserialize.ISomethingFrom(
+        ///     reader,
+        ///     "http://www.example.com/5/12");
+        /// </code>
+        /// </example>
+        public static class Deserialize
+        {
+            /// <summary>
+            /// If set, intern the deserialized strings with this function.
+            /// </summary>
+            /// <remarks>
+            /// Large data sets often repeat the same strings. Plug in, for example,
+            /// <see cref="string.Intern" /> or your own pool so that all the occurrences
+            /// of a string share a single instance.
+            /// </remarks>
+            public static System.Func<string, string>? Intern { get; set; }
+
+            /// <summary>
+            /// Deserialize an instance of ISomething from <paramref name="reader" />.
+            /// </summary>
+            /// <param name="reader">Initialized XML reader with cursor set to the element</param>
+            /// <param name="ns">
+            /// The expected namespace that the XML elements live in.
+            /// If not specified, assume the element names as-are instead of the local names.
+            /// </param>
+            /// <exception cref="Xmlization.Exception">
+            /// Thrown when the element is not a valid XML
+            /// representation of ISomething.
+            /// </exception>
+            [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]public static Aas.ISomething ISomethingFrom(
+                Xml.XmlReader reader,
+                string? ns = null)
+            {
+                Aas.ISomething? result = (
+                    DeserializeImplementation.ISomethingFromElement(
+                        reader,
+                        ns,
+                        out Reporting.Error? error));
+                if (error != null)
+                {
+                    throw new Xmlization.Exception(
+                        Reporting.GenerateRelativeXPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Blob from <paramref name="reader" />.
+            /// </summary>
+            /// <param name="reader">Initialized XML reader with cursor set to the element</param>
+            /// <param name="ns">
+            /// The expected namespace that the XML elements live in.
+            /// If not specified, assume the element names as-are instead of the local names.
+            /// </param>
+            /// <exception cref="Xmlization.Exception">
+            /// Thrown when the element is not a valid XML
+            /// representation of Blob.
+            /// </exception>
+            public static Aas.Blob BlobFrom(
+                Xml.XmlReader reader,
+                string? ns = null)
+            {
+                Aas.Blob? result = (
+                    DeserializeImplementation.BlobFromElement(
+                        reader,
+                        ns,
+                        out Reporting.Error? error));
+                if (error != null)
+                {
+                    throw new Xmlization.Exception(
+                        Reporting.GenerateRelativeXPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Note from <paramref name="reader" />.
+            /// </summary>
+            /// <param name="reader">Initialized XML reader with cursor set to the element</param>
+            /// <param name="ns">
+            /// The expected namespace that the XML elements live in.
+            /// If not specified, assume the element names as-are instead of the local names.
+            /// </param>
+            /// <exception cref="Xmlization.Exception">
+            /// Thrown when the element is not a valid XML
+            /// representation of Note.
+            /// </exception>
+            public static Aas.Note NoteFrom(
+                Xml.XmlReader reader,
+                string? ns = null)
+            {
+                Aas.Note? result = (
+                    DeserializeImplementation.NoteFromElement(
+                        reader,
+                        ns,
+                        out Reporting.Error? error));
+                if (error != null)
+                {
+                    throw new Xmlization.Exception(
+                        Reporting.GenerateRelativeXPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Container from <paramref name="reader" />.
+            /// </summary>
+            /// <param name="reader">Initialized XML reader with cursor set to the element</param>
+            /// <param name="ns">
+            /// The expected namespace that the XML elements live in.
+            /// If not specified, assume the element names as-are instead of the local names.
+            /// </param>
+            /// <exception cref="Xmlization.Exception">
+            /// Thrown when the element is not a valid XML
+            /// representation of Container.
+            /// </exception>
+            public static Aas.Container ContainerFrom(
+                Xml.XmlReader reader,
+                string? ns = null)
+            {
+                Aas.Container? result = (
+                    DeserializeImplementation.ContainerFromElement(
+                        reader,
+                        ns,
+                        out Reporting.Error? error));
+                if (error != null)
+                {
+                    throw new Xmlization.Exception(
+                        Reporting.GenerateRelativeXPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+
+            /// <summary>
+            /// Deserialize an instance of Tag from <paramref name="reader" />.
+            /// </summary>
+            /// <param name="reader">Initialized XML reader with cursor set to the element</param>
+            /// <param name="ns">
+            /// The expected namespace that the XML elements live in.
+            /// If not specified, assume the element names as-are instead of the local names.
+            /// </param>
+            /// <exception cref="Xmlization.Exception">
+            /// Thrown when the element is not a valid XML
+            /// representation of Tag.
+            /// </exception>
+            public static Aas.Tag TagFrom(
+                Xml.XmlReader reader,
+                string? ns = null)
+            {
+                Aas.Tag? result = (
+                    DeserializeImplementation.TagFromElement(
+                        reader,
+                        ns,
+                        out Reporting.Error? error));
+                if (error != null)
+                {
+                    throw new Xmlization.Exception(
+                        Reporting.GenerateRelativeXPath(error.PathSegments),
+                        error.Cause);
+                }
+                return result
+                    ?? throw new System.InvalidOperationException(
+                        "Unexpected output null when error is null");
+            }
+        }  // public static class Deserialize
+
+        /// <summary>
+        /// Serialize recursively the instances as XML elements.
+        /// </summary>
+        internal class VisitorWithWriter
+            : Visitation.AbstractVisitorWithContext<WrappedXmlWriter>
+        {
+            private void BlobToSequence(
+                Blob that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "id");
+
+                writer.WriteValue(
+                    that.Id);
+
+                writer.WriteEndElement();
+
+                if (that.Category != null)
+                {
+                    writer.WriteStartElement(
+                        "category");
+
+                    writer.WriteValue(
+                        that.Category);
+
+                    writer.WriteEndElement();
+                }
+
+                if (that.Value != null)
+                {
+                    writer.WriteStartElement(
+                        "value");
+
+                    writer.WriteBase64(
+                        that.Value);
+
+                    writer.WriteEndElement();
+                }
+
+                if (that.Kind != null)
+                {
+                    writer.WriteStartElement(
+                        "kind");
+
+                    string? textKind = Stringification.ToString(
+                        that.Kind);
+                    writer.WriteValue(
+                        textKind
+                            ?? throw new System.ArgumentException(
+                                "Invalid literal for the enumeration Kind: " +
+                                that.Kind.ToString()));
+
+                    writer.WriteEndElement();
+                }
+            }  // private void BlobToSequence
+
+            public override void Visit(
+                Aas.Blob that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "blob");
+                this.BlobToSequence(
+                    that,
+                    writer);
+                writer.WriteEndElement();
+            }
+
+            private void NoteToSequence(
+                Note that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "id");
+
+                writer.WriteValue(
+                    that.Id);
+
+                writer.WriteEndElement();
+
+                if (that.Category != null)
+                {
+                    writer.WriteStartElement(
+                        "category");
+
+                    writer.WriteValue(
+                        that.Category);
+
+                    writer.WriteEndElement();
+                }
+
+                writer.WriteStartElement(
+                    "text");
+
+                writer.WriteValue(
+                    that.Text);
+
+                writer.WriteEndElement();
+
+                if (that.Weight.HasValue)
+                {
+                    writer.WriteStartElement(
+                        "weight");
+
+                    writer.WriteValue(
+                        that.Weight.Value);
+
+                    writer.WriteEndElement();
+                }
+            }  // private void NoteToSequence
+
+            public override void Visit(
+                Aas.Note that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "note");
+                this.NoteToSequence(
+                    that,
+                    writer);
+                writer.WriteEndElement();
+            }
+
+            private void ContainerToSequence(
+                Container that,
+                WrappedXmlWriter writer)
+            {
+                if (that.Items != null)
+                {
+                    writer.WriteStartElement(
+                        "items");
+
+                    foreach (var item in that.Items)
+                    {
+                        this.Visit(
+                            item,
+                            writer);
+                    }
+
+                    writer.WriteEndElement();
+                }
+
+                if (that.Pinned != null)
+                {
+                    writer.WriteStartElement(
+                        "pinned");
+
+                    this.NoteToSequence(
+                        that.Pinned,
+                        writer);
+
+                    writer.WriteEndElement();
+                }
+
+                writer.WriteStartElement(
+                    "count");
+
+                writer.WriteValue(
+                    that.Count);
+
+                writer.WriteEndElement();
+
+                writer.WriteStartElement(
+                    "enabled");
+
+                writer.WriteValue(
+                    that.Enabled);
+
+                writer.WriteEndElement();
+            }  // private void ContainerToSequence
+
+            public override void Visit(
+                Aas.Container that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "container");
+                this.ContainerToSequence(
+                    that,
+                    writer);
+                writer.WriteEndElement();
+            }
+
+            private void TagToSequence(
+                Tag that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "label");
+
+                writer.WriteValue(
+                    that.Label);
+
+                writer.WriteEndElement();
+
+                if (that.Source != null)
+                {
+                    writer.WriteStartElement(
+                        "source");
+
+                    writer.WriteValue(
+                        that.Source);
+
+                    writer.WriteEndElement();
+                }
+            }  // private void TagToSequence
+
+            public override void Visit(
+                Aas.Tag that,
+                WrappedXmlWriter writer)
+            {
+                writer.WriteStartElement(
+                    "tag");
+                this.TagToSequence(
+                    that,
+                    writer);
+                writer.WriteEndElement();
+            }
+        }  // internal class VisitorWithWriter
+
+        /// <summary>
+        /// Wrap the writer so that we can omit the namespace and the prefix.
+        /// </summary>
+        internal class WrappedXmlWriter
+        {
+            private readonly Xml.XmlWriter _writer;
+            private readonly string? _prefix;
+            private readonly string? _ns;
+
+            internal WrappedXmlWriter(
+                Xml.XmlWriter writer,
+                string? prefix,
+                string? ns)
+            {
+                _writer = writer;
+                _prefix = prefix;
+                _ns = ns;
+            }
+
+            internal void WriteStartElement(string localName)
+            {
+                _writer.WriteStartElement(
+                    _prefix, localName, _ns);
+            }
+
+            internal void WriteEndElement()
+            {
+                _writer.WriteEndElement();
+            }
+
+            internal virtual void WriteValue(bool value)
+            {
+                _writer.WriteValue(value);
+            }
+
+            internal virtual void WriteValue(long value)
+            {
+                _writer.WriteValue(value);
+            }
+
+            internal virtual void WriteValue(double value)
+            {
+                _writer.WriteValue(value);
+            }
+
+            internal virtual void WriteValue(string? value)
+            {
+                _writer.WriteValue(value);
+            }
+
+            internal virtual void WriteBase64(byte[] buffer)
+            {
+                _writer.WriteBase64(
+                    buffer,
+                    0,
+                    buffer.Length);
+            }
+        }  // WrappedXmlWriter
+
+        /// <summary>
+        /// Serialize instances of meta-model classes to XML.
+        /// </summary>
+        /// <example>
+        /// Here is an example how to serialize an instance of ISomething:
+        /// <code>
+        /// var anInstance = new Aas.ISomething(
+        ///     /* ... some constructor arguments ... */
+        /// );
+        /// var writer = new System.Xml.XmlWriter( /* some arguments */ );
+        /// Serialize.To(
+        ///     anInstance,
+        ///     writer);
+        /// </code>
+        ///
+        /// </example>
+        /// <example>
+        /// You can also set the namespace and the prefix:
+        /// <code>
+        /// var anInstance = new Aas.ISomething(
+        ///     /* ... some constructor arguments ... */
+        /// );
+        /// var writer = new System.Xml.XmlWriter( /* some arguments */ );
+        /// Serialize.To(
+        ///     anInstance,
+        ///     writer,
+        ///     "somePrefix",
+        ///     "https://some-namespace.com");
+        /// </code>
+        /// </example>
+        public static class Serialize
+        {
+            [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
+            private static readonly VisitorWithWriter _visitorWithWriter = (
+                new VisitorWithWriter());
+
+            /// <summary>
+            /// Serialize an instance of the meta-model to XML.
+            /// </summary>
+            public static void To(
+                Aas.IClass that,
+                Xml.XmlWriter writer,
+                string? prefix = null,
+                string? ns = null)
+            {
+                var wrappedWriter = new WrappedXmlWriter(
+                    writer, prefix, ns);
+
+                Serialize._visitorWithWriter.Visit(
+                    that, wrappedWriter);
+            }
+        }  // public static class Serialize
+    }  // public static class Xmlization
+}  // namespace Dummy
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
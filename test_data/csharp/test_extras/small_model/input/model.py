@@ -0,0 +1,112 @@
+from enum import Enum
+from re import match
+from typing import List, Optional
+
+from icontract import invariant
+
+from aas_core_meta.marker import (
+    abstract,
+    access_controlled,
+    serialization,
+    verification,
+)
+
+
+class Kind(Enum):
+    Template = "Template"
+    Instance = "Instance"
+
+
+@abstract
+@serialization(with_model_type=True)
+class Something:
+    id: str
+    category: Optional[str]
+
+    def __init__(self, id: str, category: Optional[str] = None) -> None:
+        self.id = id
+        self.category = category
+
+
+@serialization(with_model_type=True)
+@access_controlled("value")
+class Blob(Something):
+    value: Optional[bytearray]
+    kind: Optional[Kind]
+
+    def __init__(
+        self,
+        id: str,
+        category: Optional[str] = None,
+        value: Optional[bytearray] = None,
+        kind: Optional[Kind] = None,
+    ) -> None:
+        Something.__init__(self, id=id, category=category)
+        self.value = value
+        self.kind = kind
+
+
+@serialization(with_model_type=True)
+class Note(Something):
+    text: str
+    weight: Optional[float]
+
+    def __init__(
+        self,
+        id: str,
+        text: str,
+        category: Optional[str] = None,
+        weight: Optional[float] = None,
+    ) -> None:
+        Something.__init__(self, id=id, category=category)
+        self.text = text
+        self.weight = weight
+
+
+@invariant(lambda self: self.count >= 0, "Count must be non-negative.")
+class Container:
+    items: Optional[List[Something]]
+    pinned: Optional[Note]
+    count: int
+    enabled: bool
+
+    def __init__(
+        self,
+        count: int,
+        enabled: bool,
+        items: Optional[List[Something]] = None,
+        pinned: Optional[Note] = None,
+    ) -> None:
+        self.count = count
+        self.enabled = enabled
+        self.items = items
+        self.pinned = pinned
+
+
+@invariant(lambda self: len(self) <= 3, "Short strings must not exceed 3 characters.")
+class Short_string(str):
+    pass
+
+
+@verification
+def matches_iri(text: str) -> bool:
+    pattern = f"^[a-zA-Z][a-zA-Z0-9+.-]*:.*$"
+    return match(pattern, text) is not None
+
+
+@invariant(lambda self: matches_iri(self), "The value must be an IRI.")
+class Iri(str):
+    pass
+
+
+class Tag:
+    label: Short_string
+    source: Optional[Iri]
+
+    def __init__(self, label: Short_string, source: Optional[Iri] = None) -> None:
+        self.label = label
+        self.source = source
+
+
+__book_url__ = "dummy"
+__book_version__ = "dummy"
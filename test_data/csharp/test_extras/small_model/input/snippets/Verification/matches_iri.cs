@@ -0,0 +1,11 @@
+/// <summary>
+/// Check that <paramref name="text" /> is a valid absolute IRI.
+/// </summary>
+/// <remarks>
+/// We delegate to <see cref="IriValidation.IsValid" /> instead of
+/// the regular expression so that the percent-encoding is checked as well.
+/// </remarks>
+public static bool MatchesIri(string text)
+{
+    return IriValidation.IsValid(text, IriValidation.Strictness.Strict);
+}
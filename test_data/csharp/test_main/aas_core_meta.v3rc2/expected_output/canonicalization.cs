@@ -0,0 +1,181 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+namespace AasCore.Aas3_0_RC02
+{
+    /// <summary>
+    /// Canonicalize the lexical values according to the XSD rules so that
+    /// the values coming from different SDKs can be compared as text.
+    /// </summary>
+    public static class Canonicalization
+    {
+        /// <summary>
+        /// Convert the round-trip representation <paramref name="roundTrip" /> of
+        /// a finite non-zero number to the canonical XSD representation.
+        /// </summary>
+        /// <remarks>
+        /// The canonical representation has a single non-zero digit before
+        /// the decimal point, no trailing zeros in the fraction except for a single
+        /// zero, and an exponent without a plus sign or leading zeros,
+        /// e.g., <c>1.0E1</c>.
+        /// </remarks>
+        private static string ToCanonicalForm(string roundTrip)
+        {
+            bool negative = roundTrip.StartsWith("-");
+            string text = negative ? roundTrip.Substring(1) : roundTrip;
+
+            int exponent = 0;
+            int exponentStart = text.IndexOfAny(new[] { 'E', 'e' });
+            if (exponentStart >= 0)
+            {
+                exponent = int.Parse(
+                    text.Substring(exponentStart + 1),
+                    System.Globalization.NumberStyles.AllowLeadingSign,
+                    System.Globalization.CultureInfo.InvariantCulture);
+                text = text.Substring(0, exponentStart);
+            }
+
+            string digits;
+            int point = text.IndexOf('.');
+            if (point >= 0)
+            {
+                digits = text.Substring(0, point) + text.Substring(point + 1);
+                exponent += point - 1;
+            }
+            else
+            {
+                digits = text;
+                exponent += text.Length - 1;
+            }
+
+            int firstNonZero = 0;
+            while (digits[firstNonZero] == '0')
+            {
+                firstNonZero++;
+                exponent--;
+            }
+
+            digits = digits.Substring(firstNonZero).TrimEnd('0');
+
+            string fraction = digits.Length > 1 ? digits.Substring(1) : "0";
+            return $"{(negative ? "-" : "")}{digits[0]}.{fraction}E{exponent}";
+        }
+
+        /// <summary>
+        /// Canonicalize the lexical <paramref name="value" /> of <c>xs:double</c>,
+        /// e.g., <c>+1e1</c> becomes <c>1.0E1</c>.
+        /// </summary>
+        /// <remarks>
+        /// The <paramref name="value" /> is assumed to be already checked against
+        /// the lexical space of <c>xs:double</c>. The values out of range are
+        /// rounded to the infinities.
+        /// </remarks>
+        /// <returns>
+        /// Canonical representation, or <c>null</c> if <paramref name="value" />
+        /// could not be parsed
+        /// </returns>
+        public static string? CanonicalizeXsDouble(string value)
+        {
+            switch (value)
+            {
+                case "INF":
+                case "+INF":
+                    return "INF";
+                case "-INF":
+                    return "-INF";
+                case "NaN":
+                    return "NaN";
+            }
+
+            if (!double.TryParse(
+                value,
+                System.Globalization.NumberStyles.Float,
+                System.Globalization.CultureInfo.InvariantCulture,
+                out double parsed))
+            {
+                return null;
+            }
+
+            if (double.IsPositiveInfinity(parsed))
+            {
+                return "INF";
+            }
+
+            if (double.IsNegativeInfinity(parsed))
+            {
+                return "-INF";
+            }
+
+            if (parsed == 0)
+            {
+                return value.StartsWith("-") ? "-0.0E0" : "0.0E0";
+            }
+
+            return ToCanonicalForm(
+                parsed.ToString(
+                    "R", System.Globalization.CultureInfo.InvariantCulture));
+        }
+
+        /// <summary>
+        /// Canonicalize the lexical <paramref name="value" /> of <c>xs:float</c>,
+        /// e.g., <c>+1e1</c> becomes <c>1.0E1</c>.
+        /// </summary>
+        /// <remarks>
+        /// The <paramref name="value" /> is assumed to be already checked against
+        /// the lexical space of <c>xs:float</c>. The values out of range are
+        /// rounded to the infinities.
+        /// </remarks>
+        /// <returns>
+        /// Canonical representation, or <c>null</c> if <paramref name="value" />
+        /// could not be parsed
+        /// </returns>
+        public static string? CanonicalizeXsFloat(string value)
+        {
+            switch (value)
+            {
+                case "INF":
+                case "+INF":
+                    return "INF";
+                case "-INF":
+                    return "-INF";
+                case "NaN":
+                    return "NaN";
+            }
+
+            if (!float.TryParse(
+                value,
+                System.Globalization.NumberStyles.Float,
+                System.Globalization.CultureInfo.InvariantCulture,
+                out float parsed))
+            {
+                return null;
+            }
+
+            if (float.IsPositiveInfinity(parsed))
+            {
+                return "INF";
+            }
+
+            if (float.IsNegativeInfinity(parsed))
+            {
+                return "-INF";
+            }
+
+            if (parsed == 0)
+            {
+                return value.StartsWith("-") ? "-0.0E0" : "0.0E0";
+            }
+
+            return ToCanonicalForm(
+                parsed.ToString(
+                    "R", System.Globalization.CultureInfo.InvariantCulture));
+        }
+    }  // public static class Canonicalization
+}  // namespace AasCore.Aas3_0_RC02
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -0,0 +1,143 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+namespace AasCore.Aas3_0_RC02
+{
+    /// <summary>
+    /// Validate IRIs and URIs uniformly.
+    /// </summary>
+    /// <remarks>
+    /// To use this validator instead of the permissive pattern in the verification,
+    /// provide the snippet <c>Verification/matches_xs_any_uri.cs</c>:
+    /// <code>
+    /// public static bool MatchesXsAnyUri(string text)
+    /// {
+    ///     return IriValidation.IsValid(text, IriValidation.Strictness.Lenient);
+    /// }
+    /// </code>
+    /// </remarks>
+    public static class IriValidation
+    {
+        /// <summary>
+        /// Define how strictly the IRIs are validated.
+        /// </summary>
+        public enum Strictness
+        {
+            /// <summary>
+            /// Accept both absolute IRIs and relative references.
+            /// </summary>
+            Lenient,
+
+            /// <summary>
+            /// Accept only absolute IRIs which start with a scheme.
+            /// </summary>
+            Strict
+        }
+
+        private static bool IsAllowedCharacter(char character)
+        {
+            if (character <= ' ' || character == '\u007F')
+            {
+                return false;
+            }
+
+            switch (character)
+            {
+                case '<':
+                case '>':
+                case '"':
+                case '{':
+                case '}':
+                case '|':
+                case '\\':
+                case '^':
+                case '`':
+                    return false;
+                default:
+                    return true;
+            }
+        }
+
+        private static bool HasScheme(string text)
+        {
+            if (text.Length == 0 || !IsAsciiLetter(text[0]))
+            {
+                return false;
+            }
+
+            for (int i = 1; i < text.Length; i++)
+            {
+                char character = text[i];
+                if (character == ':')
+                {
+                    return true;
+                }
+
+                if (!IsAsciiLetter(character)
+                    && !(character >= '0' && character <= '9')
+                    && character != '+'
+                    && character != '-'
+                    && character != '.')
+                {
+                    return false;
+                }
+            }
+
+            return false;
+        }
+
+        private static bool IsAsciiLetter(char character)
+        {
+            return (character >= 'a' && character <= 'z')
+                || (character >= 'A' && character <= 'Z');
+        }
+
+        /// <summary>
+        /// Check whether <paramref name="text" /> is a valid IRI.
+        /// </summary>
+        /// <remarks>
+        /// The characters which are forbidden by RFC 3987, such as spaces,
+        /// control characters and <c>&lt;&gt;"{}|\^`</c>, are rejected in all
+        /// the modes. Every <c>%</c> must be followed by two hexadecimal digits.
+        /// </remarks>
+        /// <param name="text">to be checked</param>
+        /// <param name="strictness">how strictly the IRI is validated</param>
+        /// <returns>true if the IRI is valid</returns>
+        public static bool IsValid(
+            string text,
+            Strictness strictness = Strictness.Strict)
+        {
+            for (int i = 0; i < text.Length; i++)
+            {
+                char character = text[i];
+
+                if (character == '%')
+                {
+                    if (i + 2 >= text.Length
+                        || !System.Uri.IsHexDigit(text[i + 1])
+                        || !System.Uri.IsHexDigit(text[i + 2]))
+                    {
+                        return false;
+                    }
+
+                    i += 2;
+                    continue;
+                }
+
+                if (!IsAllowedCharacter(character))
+                {
+                    return false;
+                }
+            }
+
+            return strictness == Strictness.Lenient || HasScheme(text);
+        }
+    }  // public static class IriValidation
+}  // namespace AasCore.Aas3_0_RC02
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
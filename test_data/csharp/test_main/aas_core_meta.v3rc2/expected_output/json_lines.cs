@@ -0,0 +1,133 @@
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
+
+using Nodes = System.Text.Json.Nodes;
+using System.Collections.Generic;  // can't alias
+
+using Aas = AasCore.Aas3_0_RC02;
+
+namespace AasCore.Aas3_0_RC02
+{
+    /// <summary>
+    /// Stream instances as JSON Lines (NDJSON) for bulk import and export.
+    /// </summary>
+    public static class JsonLines
+    {
+        /// <summary>
+        /// Represent the outcome of reading a single line.
+        /// </summary>
+        /// <remarks>
+        /// Exactly one of <see cref="Instance" /> and <see cref="Error" /> is set.
+        /// </remarks>
+        public class Result<T> where T : class
+        {
+            /// <summary>
+            /// 1-based number of the line in the input
+            /// </summary>
+            public int LineNumber { get; }
+
+            /// <summary>
+            /// Deserialized instance, if the line could be read
+            /// </summary>
+            public T? Instance { get; }
+
+            /// <summary>
+            /// Description of the error, if the line could not be read
+            /// </summary>
+            public string? Error { get; }
+
+            public Result(int lineNumber, T? instance, string? error)
+            {
+                LineNumber = lineNumber;
+                Instance = instance;
+                Error = error;
+            }
+        }
+
+        private static Result<T> ReadLine<T>(
+            int lineNumber,
+            string line,
+            System.Func<Nodes.JsonNode, T> deserialize) where T : class
+        {
+            Nodes.JsonNode? node;
+            try
+            {
+                node = Nodes.JsonNode.Parse(line);
+            }
+            catch (System.Text.Json.JsonException exception)
+            {
+                return new Result<T>(lineNumber, null, exception.Message);
+            }
+
+            if (node == null)
+            {
+                return new Result<T>(
+                    lineNumber, null, "Expected a JSON object, but got null");
+            }
+
+            try
+            {
+                return new Result<T>(lineNumber, deserialize(node), null);
+            }
+            catch (Jsonization.Exception exception)
+            {
+                return new Result<T>(lineNumber, null, exception.Message);
+            }
+        }
+
+        /// <summary>
+        /// Read the instances from <paramref name="reader" />, one JSON object per line.
+        /// </summary>
+        /// <remarks>
+        /// An invalid line does not stop the reading, but is reported as
+        /// a result with an error so that the bulk imports can skip it.
+        /// The empty lines are ignored.
+        /// </remarks>
+        /// <param name="reader">to read the lines from</param>
+        /// <param name="deserialize">
+        /// deserialize a JSON node, <em>e.g.</em>, one of
+        /// the <c>Jsonization.Deserialize.*From</c> functions
+        /// </param>
+        public static IEnumerable<Result<T>> Read<T>(
+            System.IO.TextReader reader,
+            System.Func<Nodes.JsonNode, T> deserialize) where T : class
+        {
+            int lineNumber = 0;
+            string? line;
+            while ((line = reader.ReadLine()) != null)
+            {
+                lineNumber++;
+
+                if (line.Trim().Length == 0)
+                {
+                    continue;
+                }
+
+                yield return ReadLine(lineNumber, line, deserialize);
+            }
+        }
+
+        /// <summary>
+        /// Write the <paramref name="instances" /> to <paramref name="writer" />,
+        /// one JSON object per line.
+        /// </summary>
+        public static void Write(
+            System.IO.TextWriter writer,
+            IEnumerable<Aas.IClass> instances)
+        {
+            foreach (var instance in instances)
+            {
+                writer.Write(
+                    Jsonization.Serialize.ToJsonObject(instance).ToJsonString());
+                writer.Write('\n');
+            }
+        }
+    }  // public static class JsonLines
+}  // namespace AasCore.Aas3_0_RC02
+
+/*
+ * This code has been automatically generated by aas-core-codegen.
+ * Do NOT edit or append.
+ */
@@ -152,7 +152,10 @@ namespace AasCore.Aas3_0_RC02
                         "Expected a string, but got a null");
                     return null;
                 }
-                return result;
+                var intern = Deserialize.Intern;
+                return (intern != null)
+                    ? intern(result)
+                    : result;
             }
 
             /// <summary>
@@ -12866,6 +12869,16 @@ namespace AasCore.Aas3_0_RC02
         /// </example>
         public static class Deserialize
         {
+            /// <summary>
+            /// If set, intern the deserialized strings with this function.
+            /// </summary>
+            /// <remarks>
+            /// Large data sets often repeat the same strings. Plug in, for example,
+            /// <see cref="string.Intern" /> or your own pool so that all the occurrences
+            /// of a string share a single instance.
+            /// </remarks>
+            public static System.Func<string, string>? Intern { get; set; }
+
             /// <summary>
             /// Deserialize an instance of IHasSemantics from <paramref name="node" />.
             /// </summary>
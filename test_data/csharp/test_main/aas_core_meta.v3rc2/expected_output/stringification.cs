@@ -3,7 +3,6 @@
  * Do NOT edit or append.
  */
 
-using CodeAnalysis = System.Diagnostics.CodeAnalysis;
 using System.Collections.Generic;  // can't alias
 
 using Aas = AasCore.Aas3_0_RC02;
@@ -44,14 +43,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.ModelingKind> _modelingKindFromString = (
-            new Dictionary<string, Aas.ModelingKind>()
-            {
-                { "Template", Aas.ModelingKind.Template },
-                { "Instance", Aas.ModelingKind.Instance }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="ModelingKind" />.
         /// </summary>
@@ -62,13 +53,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.ModelingKind? ModelingKindFromString(string text)
         {
-            if (_modelingKindFromString.TryGetValue(text, out ModelingKind value))
-            {
-                return value;
-            }
-            else
+            switch (text)
             {
-                return null;
+                case "Template":
+                    return Aas.ModelingKind.Template;
+                case "Instance":
+                    return Aas.ModelingKind.Instance;
+                default:
+                    return null;
             }
         }
 
@@ -105,15 +97,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.QualifierKind> _qualifierKindFromString = (
-            new Dictionary<string, Aas.QualifierKind>()
-            {
-                { "ValueQualifier", Aas.QualifierKind.ValueQualifier },
-                { "ConceptQualifier", Aas.QualifierKind.ConceptQualifier },
-                { "TemplateQualifier", Aas.QualifierKind.TemplateQualifier }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="QualifierKind" />.
         /// </summary>
@@ -124,13 +107,16 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.QualifierKind? QualifierKindFromString(string text)
         {
-            if (_qualifierKindFromString.TryGetValue(text, out QualifierKind value))
-            {
-                return value;
-            }
-            else
-            {
-                return null;
+            switch (text)
+            {
+                case "ValueQualifier":
+                    return Aas.QualifierKind.ValueQualifier;
+                case "ConceptQualifier":
+                    return Aas.QualifierKind.ConceptQualifier;
+                case "TemplateQualifier":
+                    return Aas.QualifierKind.TemplateQualifier;
+                default:
+                    return null;
             }
         }
 
@@ -166,14 +152,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.AssetKind> _assetKindFromString = (
-            new Dictionary<string, Aas.AssetKind>()
-            {
-                { "Type", Aas.AssetKind.Type },
-                { "Instance", Aas.AssetKind.Instance }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="AssetKind" />.
         /// </summary>
@@ -184,13 +162,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.AssetKind? AssetKindFromString(string text)
         {
-            if (_assetKindFromString.TryGetValue(text, out AssetKind value))
+            switch (text)
             {
-                return value;
-            }
-            else
-            {
-                return null;
+                case "Type":
+                    return Aas.AssetKind.Type;
+                case "Instance":
+                    return Aas.AssetKind.Instance;
+                default:
+                    return null;
             }
         }
 
@@ -241,29 +220,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.AasSubmodelElements> _aasSubmodelElementsFromString = (
-            new Dictionary<string, Aas.AasSubmodelElements>()
-            {
-                { "AnnotatedRelationshipElement", Aas.AasSubmodelElements.AnnotatedRelationshipElement },
-                { "BasicEventElement", Aas.AasSubmodelElements.BasicEventElement },
-                { "Blob", Aas.AasSubmodelElements.Blob },
-                { "Capability", Aas.AasSubmodelElements.Capability },
-                { "DataElement", Aas.AasSubmodelElements.DataElement },
-                { "Entity", Aas.AasSubmodelElements.Entity },
-                { "EventElement", Aas.AasSubmodelElements.EventElement },
-                { "File", Aas.AasSubmodelElements.File },
-                { "MultiLanguageProperty", Aas.AasSubmodelElements.MultiLanguageProperty },
-                { "Operation", Aas.AasSubmodelElements.Operation },
-                { "Property", Aas.AasSubmodelElements.Property },
-                { "Range", Aas.AasSubmodelElements.Range },
-                { "ReferenceElement", Aas.AasSubmodelElements.ReferenceElement },
-                { "RelationshipElement", Aas.AasSubmodelElements.RelationshipElement },
-                { "SubmodelElement", Aas.AasSubmodelElements.SubmodelElement },
-                { "SubmodelElementList", Aas.AasSubmodelElements.SubmodelElementList },
-                { "SubmodelElementCollection", Aas.AasSubmodelElements.SubmodelElementCollection }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="AasSubmodelElements" />.
         /// </summary>
@@ -274,13 +230,44 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.AasSubmodelElements? AasSubmodelElementsFromString(string text)
         {
-            if (_aasSubmodelElementsFromString.TryGetValue(text, out AasSubmodelElements value))
-            {
-                return value;
-            }
-            else
-            {
-                return null;
+            switch (text)
+            {
+                case "AnnotatedRelationshipElement":
+                    return Aas.AasSubmodelElements.AnnotatedRelationshipElement;
+                case "BasicEventElement":
+                    return Aas.AasSubmodelElements.BasicEventElement;
+                case "Blob":
+                    return Aas.AasSubmodelElements.Blob;
+                case "Capability":
+                    return Aas.AasSubmodelElements.Capability;
+                case "DataElement":
+                    return Aas.AasSubmodelElements.DataElement;
+                case "Entity":
+                    return Aas.AasSubmodelElements.Entity;
+                case "EventElement":
+                    return Aas.AasSubmodelElements.EventElement;
+                case "File":
+                    return Aas.AasSubmodelElements.File;
+                case "MultiLanguageProperty":
+                    return Aas.AasSubmodelElements.MultiLanguageProperty;
+                case "Operation":
+                    return Aas.AasSubmodelElements.Operation;
+                case "Property":
+                    return Aas.AasSubmodelElements.Property;
+                case "Range":
+                    return Aas.AasSubmodelElements.Range;
+                case "ReferenceElement":
+                    return Aas.AasSubmodelElements.ReferenceElement;
+                case "RelationshipElement":
+                    return Aas.AasSubmodelElements.RelationshipElement;
+                case "SubmodelElement":
+                    return Aas.AasSubmodelElements.SubmodelElement;
+                case "SubmodelElementList":
+                    return Aas.AasSubmodelElements.SubmodelElementList;
+                case "SubmodelElementCollection":
+                    return Aas.AasSubmodelElements.SubmodelElementCollection;
+                default:
+                    return null;
             }
         }
 
@@ -316,14 +303,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.EntityType> _entityTypeFromString = (
-            new Dictionary<string, Aas.EntityType>()
-            {
-                { "CoManagedEntity", Aas.EntityType.CoManagedEntity },
-                { "SelfManagedEntity", Aas.EntityType.SelfManagedEntity }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="EntityType" />.
         /// </summary>
@@ -334,13 +313,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.EntityType? EntityTypeFromString(string text)
         {
-            if (_entityTypeFromString.TryGetValue(text, out EntityType value))
+            switch (text)
             {
-                return value;
-            }
-            else
-            {
-                return null;
+                case "CoManagedEntity":
+                    return Aas.EntityType.CoManagedEntity;
+                case "SelfManagedEntity":
+                    return Aas.EntityType.SelfManagedEntity;
+                default:
+                    return null;
             }
         }
 
@@ -376,14 +356,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.Direction> _directionFromString = (
-            new Dictionary<string, Aas.Direction>()
-            {
-                { "INPUT", Aas.Direction.Input },
-                { "OUTPUT", Aas.Direction.Output }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="Direction" />.
         /// </summary>
@@ -394,13 +366,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.Direction? DirectionFromString(string text)
         {
-            if (_directionFromString.TryGetValue(text, out Direction value))
-            {
-                return value;
-            }
-            else
+            switch (text)
             {
-                return null;
+                case "INPUT":
+                    return Aas.Direction.Input;
+                case "OUTPUT":
+                    return Aas.Direction.Output;
+                default:
+                    return null;
             }
         }
 
@@ -436,14 +409,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.StateOfEvent> _stateOfEventFromString = (
-            new Dictionary<string, Aas.StateOfEvent>()
-            {
-                { "ON", Aas.StateOfEvent.On },
-                { "OFF", Aas.StateOfEvent.Off }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="StateOfEvent" />.
         /// </summary>
@@ -454,13 +419,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.StateOfEvent? StateOfEventFromString(string text)
         {
-            if (_stateOfEventFromString.TryGetValue(text, out StateOfEvent value))
+            switch (text)
             {
-                return value;
-            }
-            else
-            {
-                return null;
+                case "ON":
+                    return Aas.StateOfEvent.On;
+                case "OFF":
+                    return Aas.StateOfEvent.Off;
+                default:
+                    return null;
             }
         }
 
@@ -496,14 +462,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.ReferenceTypes> _referenceTypesFromString = (
-            new Dictionary<string, Aas.ReferenceTypes>()
-            {
-                { "GlobalReference", Aas.ReferenceTypes.GlobalReference },
-                { "ModelReference", Aas.ReferenceTypes.ModelReference }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="ReferenceTypes" />.
         /// </summary>
@@ -514,13 +472,14 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.ReferenceTypes? ReferenceTypesFromString(string text)
         {
-            if (_referenceTypesFromString.TryGetValue(text, out ReferenceTypes value))
-            {
-                return value;
-            }
-            else
+            switch (text)
             {
-                return null;
+                case "GlobalReference":
+                    return Aas.ReferenceTypes.GlobalReference;
+                case "ModelReference":
+                    return Aas.ReferenceTypes.ModelReference;
+                default:
+                    return null;
             }
         }
 
@@ -578,36 +537,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.KeyTypes> _keyTypesFromString = (
-            new Dictionary<string, Aas.KeyTypes>()
-            {
-                { "FragmentReference", Aas.KeyTypes.FragmentReference },
-                { "GlobalReference", Aas.KeyTypes.GlobalReference },
-                { "AnnotatedRelationshipElement", Aas.KeyTypes.AnnotatedRelationshipElement },
-                { "AssetAdministrationShell", Aas.KeyTypes.AssetAdministrationShell },
-                { "BasicEventElement", Aas.KeyTypes.BasicEventElement },
-                { "Blob", Aas.KeyTypes.Blob },
-                { "Capability", Aas.KeyTypes.Capability },
-                { "ConceptDescription", Aas.KeyTypes.ConceptDescription },
-                { "Identifiable", Aas.KeyTypes.Identifiable },
-                { "DataElement", Aas.KeyTypes.DataElement },
-                { "Entity", Aas.KeyTypes.Entity },
-                { "EventElement", Aas.KeyTypes.EventElement },
-                { "File", Aas.KeyTypes.File },
-                { "MultiLanguageProperty", Aas.KeyTypes.MultiLanguageProperty },
-                { "Operation", Aas.KeyTypes.Operation },
-                { "Property", Aas.KeyTypes.Property },
-                { "Range", Aas.KeyTypes.Range },
-                { "ReferenceElement", Aas.KeyTypes.ReferenceElement },
-                { "Referable", Aas.KeyTypes.Referable },
-                { "RelationshipElement", Aas.KeyTypes.RelationshipElement },
-                { "Submodel", Aas.KeyTypes.Submodel },
-                { "SubmodelElement", Aas.KeyTypes.SubmodelElement },
-                { "SubmodelElementList", Aas.KeyTypes.SubmodelElementList },
-                { "SubmodelElementCollection", Aas.KeyTypes.SubmodelElementCollection }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="KeyTypes" />.
         /// </summary>
@@ -618,13 +547,58 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.KeyTypes? KeyTypesFromString(string text)
         {
-            if (_keyTypesFromString.TryGetValue(text, out KeyTypes value))
-            {
-                return value;
-            }
-            else
-            {
-                return null;
+            switch (text)
+            {
+                case "FragmentReference":
+                    return Aas.KeyTypes.FragmentReference;
+                case "GlobalReference":
+                    return Aas.KeyTypes.GlobalReference;
+                case "AnnotatedRelationshipElement":
+                    return Aas.KeyTypes.AnnotatedRelationshipElement;
+                case "AssetAdministrationShell":
+                    return Aas.KeyTypes.AssetAdministrationShell;
+                case "BasicEventElement":
+                    return Aas.KeyTypes.BasicEventElement;
+                case "Blob":
+                    return Aas.KeyTypes.Blob;
+                case "Capability":
+                    return Aas.KeyTypes.Capability;
+                case "ConceptDescription":
+                    return Aas.KeyTypes.ConceptDescription;
+                case "Identifiable":
+                    return Aas.KeyTypes.Identifiable;
+                case "DataElement":
+                    return Aas.KeyTypes.DataElement;
+                case "Entity":
+                    return Aas.KeyTypes.Entity;
+                case "EventElement":
+                    return Aas.KeyTypes.EventElement;
+                case "File":
+                    return Aas.KeyTypes.File;
+                case "MultiLanguageProperty":
+                    return Aas.KeyTypes.MultiLanguageProperty;
+                case "Operation":
+                    return Aas.KeyTypes.Operation;
+                case "Property":
+                    return Aas.KeyTypes.Property;
+                case "Range":
+                    return Aas.KeyTypes.Range;
+                case "ReferenceElement":
+                    return Aas.KeyTypes.ReferenceElement;
+                case "Referable":
+                    return Aas.KeyTypes.Referable;
+                case "RelationshipElement":
+                    return Aas.KeyTypes.RelationshipElement;
+                case "Submodel":
+                    return Aas.KeyTypes.Submodel;
+                case "SubmodelElement":
+                    return Aas.KeyTypes.SubmodelElement;
+                case "SubmodelElementList":
+                    return Aas.KeyTypes.SubmodelElementList;
+                case "SubmodelElementCollection":
+                    return Aas.KeyTypes.SubmodelElementCollection;
+                default:
+                    return null;
             }
         }
 
@@ -691,45 +665,6 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        private static readonly Dictionary<string, Aas.DataTypeDefXsd> _dataTypeDefXsdFromString = (
-            new Dictionary<string, Aas.DataTypeDefXsd>()
-            {
-                { "xs:anyURI", Aas.DataTypeDefXsd.AnyUri },
-                { "xs:base64Binary", Aas.DataTypeDefXsd.Base64Binary },
-                { "xs:boolean", Aas.DataTypeDefXsd.Boolean },
-                { "xs:date", Aas.DataTypeDefXsd.Date },
-                { "xs:dateTime", Aas.DataTypeDefXsd.DateTime },
-                { "xs:dateTimeStamp", Aas.DataTypeDefXsd.DateTimeStamp },
-                { "xs:decimal", Aas.DataTypeDefXsd.Decimal },
-                { "xs:double", Aas.DataTypeDefXsd.Double },
-                { "xs:duration", Aas.DataTypeDefXsd.Duration },
-                { "xs:float", Aas.DataTypeDefXsd.Float },
-                { "xs:gDay", Aas.DataTypeDefXsd.GDay },
-                { "xs:gMonth", Aas.DataTypeDefXsd.GMonth },
-                { "xs:gMonthDay", Aas.DataTypeDefXsd.GMonthDay },
-                { "xs:gYear", Aas.DataTypeDefXsd.GYear },
-                { "xs:gYearMonth", Aas.DataTypeDefXsd.GYearMonth },
-                { "xs:hexBinary", Aas.DataTypeDefXsd.HexBinary },
-                { "xs:string", Aas.DataTypeDefXsd.String },
-                { "xs:time", Aas.DataTypeDefXsd.Time },
-                { "xs:dayTimeDuration", Aas.DataTypeDefXsd.DayTimeDuration },
-                { "xs:yearMonthDuration", Aas.DataTypeDefXsd.YearMonthDuration },
-                { "xs:integer", Aas.DataTypeDefXsd.Integer },
-                { "xs:long", Aas.DataTypeDefXsd.Long },
-                { "xs:int", Aas.DataTypeDefXsd.Int },
-                { "xs:short", Aas.DataTypeDefXsd.Short },
-                { "xs:byte", Aas.DataTypeDefXsd.Byte },
-                { "xs:NonNegativeInteger", Aas.DataTypeDefXsd.NonNegativeInteger },
-                { "xs:positiveInteger", Aas.DataTypeDefXsd.PositiveInteger },
-                { "xs:unsignedLong", Aas.DataTypeDefXsd.UnsignedLong },
-                { "xs:unsignedInt", Aas.DataTypeDefXsd.UnsignedInt },
-                { "xs:unsignedShort", Aas.DataTypeDefXsd.UnsignedShort },
-                { "xs:unsignedByte", Aas.DataTypeDefXsd.UnsignedByte },
-                { "xs:nonPositiveInteger", Aas.DataTypeDefXsd.NonPositiveInteger },
-                { "xs:negativeInteger", Aas.DataTypeDefXsd.NegativeInteger }
-            });
-
         /// <summary>
         /// Parse the string representation of <see cref="DataTypeDefXsd" />.
         /// </summary>
@@ -740,13 +675,76 @@ namespace AasCore.Aas3_0_RC02
         /// </remarks>
         public static Aas.DataTypeDefXsd? DataTypeDefXsdFromString(string text)
         {
-            if (_dataTypeDefXsdFromString.TryGetValue(text, out DataTypeDefXsd value))
-            {
-                return value;
-            }
-            else
-            {
-                return null;
+            switch (text)
+            {
+                case "xs:anyURI":
+                    return Aas.DataTypeDefXsd.AnyUri;
+                case "xs:base64Binary":
+                    return Aas.DataTypeDefXsd.Base64Binary;
+                case "xs:boolean":
+                    return Aas.DataTypeDefXsd.Boolean;
+                case "xs:date":
+                    return Aas.DataTypeDefXsd.Date;
+                case "xs:dateTime":
+                    return Aas.DataTypeDefXsd.DateTime;
+                case "xs:dateTimeStamp":
+                    return Aas.DataTypeDefXsd.DateTimeStamp;
+                case "xs:decimal":
+                    return Aas.DataTypeDefXsd.Decimal;
+                case "xs:double":
+                    return Aas.DataTypeDefXsd.Double;
+                case "xs:duration":
+                    return Aas.DataTypeDefXsd.Duration;
+                case "xs:float":
+                    return Aas.DataTypeDefXsd.Float;
+                case "xs:gDay":
+                    return Aas.DataTypeDefXsd.GDay;
+                case "xs:gMonth":
+                    return Aas.DataTypeDefXsd.GMonth;
+                case "xs:gMonthDay":
+                    return Aas.DataTypeDefXsd.GMonthDay;
+                case "xs:gYear":
+                    return Aas.DataTypeDefXsd.GYear;
+                case "xs:gYearMonth":
+                    return Aas.DataTypeDefXsd.GYearMonth;
+                case "xs:hexBinary":
+                    return Aas.DataTypeDefXsd.HexBinary;
+                case "xs:string":
+                    return Aas.DataTypeDefXsd.String;
+                case "xs:time":
+                    return Aas.DataTypeDefXsd.Time;
+                case "xs:dayTimeDuration":
+                    return Aas.DataTypeDefXsd.DayTimeDuration;
+                case "xs:yearMonthDuration":
+                    return Aas.DataTypeDefXsd.YearMonthDuration;
+                case "xs:integer":
+                    return Aas.DataTypeDefXsd.Integer;
+                case "xs:long":
+                    return Aas.DataTypeDefXsd.Long;
+                case "xs:int":
+                    return Aas.DataTypeDefXsd.Int;
+                case "xs:short":
+                    return Aas.DataTypeDefXsd.Short;
+                case "xs:byte":
+                    return Aas.DataTypeDefXsd.Byte;
+                case "xs:NonNegativeInteger":
+                    return Aas.DataTypeDefXsd.NonNegativeInteger;
+                case "xs:positiveInteger":
+                    return Aas.DataTypeDefXsd.PositiveInteger;
+                case "xs:unsignedLong":
+                    return Aas.DataTypeDefXsd.UnsignedLong;
+                case "xs:unsignedInt":
+                    return Aas.DataTypeDefXsd.UnsignedInt;
+                case "xs:unsignedShort":
+                    return Aas.DataTypeDefXsd.UnsignedShort;
+                case "xs:unsignedByte":
+                    return Aas.DataTypeDefXsd.UnsignedByte;
+                case "xs:nonPositiveInteger":
+                    return Aas.DataTypeDefXsd.NonPositiveInteger;
+                case "xs:negativeInteger":
+                    return Aas.DataTypeDefXsd.NegativeInteger;
+                default:
+                    return null;
             }
         }
     }  // public static class Stringification
@@ -343,69 +343,17 @@ namespace AasCore.Aas3_0_RC02
             return true;
         }
 
-        [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
-        [CodeAnalysis.SuppressMessageAttribute("ReSharper", "IdentifierTypo")]
-        [CodeAnalysis.SuppressMessage("ReSharper", "StringLiteralTypo")]
-        private static Regex _constructMatchesXsAnyUri()
-        {
-            var scheme = "[a-zA-Z][a-zA-Z0-9+\\-.]*";
-            var ucschar = "([\\xa0-\\ud7ff\\uf900-\\ufdcf\\ufdf0-\\uffef]|\\ud800[\\udc00-\\udfff]|[\\ud801-\\ud83e][\\udc00-\\udfff]|\\ud83f[\\udc00-\\udffd]|\\ud840[\\udc00-\\udfff]|[\\ud841-\\ud87e][\\udc00-\\udfff]|\\ud87f[\\udc00-\\udffd]|\\ud880[\\udc00-\\udfff]|[\\ud881-\\ud8be][\\udc00-\\udfff]|\\ud8bf[\\udc00-\\udffd]|\\ud8c0[\\udc00-\\udfff]|[\\ud8c1-\\ud8fe][\\udc00-\\udfff]|\\ud8ff[\\udc00-\\udffd]|\\ud900[\\udc00-\\udfff]|[\\ud901-\\ud93e][\\udc00-\\udfff]|\\ud93f[\\udc00-\\udffd]|\\ud940[\\udc00-\\udfff]|[\\ud941-\\ud97e][\\udc00-\\udfff]|\\ud97f[\\udc00-\\udffd]|\\ud980[\\udc00-\\udfff]|[\\ud981-\\ud9be][\\udc00-\\udfff]|\\ud9bf[\\udc00-\\udffd]|\\ud9c0[\\udc00-\\udfff]|[\\ud9c1-\\ud9fe][\\udc00-\\udfff]|\\ud9ff[\\udc00-\\udffd]|\\uda00[\\udc00-\\udfff]|[\\uda01-\\uda3e][\\udc00-\\udfff]|\\uda3f[\\udc00-\\udffd]|\\uda40[\\udc00-\\udfff]|[\\uda41-\\uda7e][\\udc00-\\udfff]|\\uda7f[\\udc00-\\udffd]|\\uda80[\\udc00-\\udfff]|[\\uda81-\\udabe][\\udc00-\\udfff]|\\udabf[\\udc00-\\udffd]|\\udac0[\\udc00-\\udfff]|[\\udac1-\\udafe][\\udc00-\\udfff]|\\udaff[\\udc00-\\udffd]|\\udb00[\\udc00-\\udfff]|[\\udb01-\\udb3e][\\udc00-\\udfff]|\\udb3f[\\udc00-\\udffd]|\\udb44[\\udc00-\\udfff]|[\\udb45-\\udb7e][\\udc00-\\udfff]|\\udb7f[\\udc00-\\udffd])";
-            var iunreserved = $"([a-zA-Z0-9\\-._~]|{ucschar})";
-            var pctEncoded = "%[0-9A-Fa-f][0-9A-Fa-f]";
-            var subDelims = "[!$&'()*+,;=]";
-            var iuserinfo = $"({iunreserved}|{pctEncoded}|{subDelims}|:)*";
-            var h16 = "[0-9A-Fa-f]{1,4}";
-            var decOctet = "([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])";
-            var ipv4address = $"{decOctet}\\.{decOctet}\\.{decOctet}\\.{decOctet}";
-            var ls32 = $"({h16}:{h16}|{ipv4address})";
-            var ipv6address = $"(({h16}:){{6}}{ls32}|::({h16}:){{5}}{ls32}|({h16})?::({h16}:){{4}}{ls32}|(({h16}:)?{h16})?::({h16}:){{3}}{ls32}|(({h16}:){{2}}{h16})?::({h16}:){{2}}{ls32}|(({h16}:){{3}}{h16})?::{h16}:{ls32}|(({h16}:){{4}}{h16})?::{ls32}|(({h16}:){{5}}{h16})?::{h16}|(({h16}:){{6}}{h16})?::)";
-            var unreserved = "[a-zA-Z0-9\\-._~]";
-            var ipvfuture = $"[vV][0-9A-Fa-f]+\\.({unreserved}|{subDelims}|:)+";
-            var ipLiteral = $"\\[({ipv6address}|{ipvfuture})\\]";
-            var iregName = $"({iunreserved}|{pctEncoded}|{subDelims})*";
-            var ihost = $"({ipLiteral}|{ipv4address}|{iregName})";
-            var port = "[0-9]*";
-            var iauthority = $"({iuserinfo}@)?{ihost}(:{port})?";
-            var ipchar = $"({iunreserved}|{pctEncoded}|{subDelims}|[:@])";
-            var isegment = $"({ipchar})*";
-            var ipathAbempty = $"(/{isegment})*";
-            var isegmentNz = $"({ipchar})+";
-            var ipathAbsolute = $"/({isegmentNz}(/{isegment})*)?";
-            var ipathRootless = $"{isegmentNz}(/{isegment})*";
-            var ipathEmpty = $"({ipchar}){{0}}";
-            var ihierPart = $"(//{iauthority}{ipathAbempty}|{ipathAbsolute}|{ipathRootless}|{ipathEmpty})";
-            var iprivate = "([\\ue000-\\uf8ff]|\\udb80[\\udc00-\\udfff]|[\\udb81-\\udbbe][\\udc00-\\udfff]|\\udbbf[\\udc00-\\udffd]|\\udbc0[\\udc00-\\udfff]|[\\udbc1-\\udbfe][\\udc00-\\udfff]|\\udbff[\\udc00-\\udffd])";
-            var iquery = $"({ipchar}|{iprivate}|[/?])*";
-            var ifragment = $"({ipchar}|[/?])*";
-            var isegmentNzNc = $"({iunreserved}|{pctEncoded}|{subDelims}|@)+";
-            var ipathNoscheme = $"{isegmentNzNc}(/{isegment})*";
-            var irelativePart = $"(//{iauthority}{ipathAbempty}|{ipathAbsolute}|{ipathNoscheme}|{ipathEmpty})";
-            var irelativeRef = $"{irelativePart}(\\?{iquery})?(#{ifragment})?";
-            var iri = $"{scheme}:{ihierPart}(\\?{iquery})?(#{ifragment})?";
-            var iriReference = $"({iri}|{irelativeRef})";
-            var pattern = $"^{iriReference}$";
-
-            return new Regex(pattern);
-        }
-
-        private static readonly Regex RegexMatchesXsAnyUri = _constructMatchesXsAnyUri();
-
         /// <summary>
         /// Check that <paramref name="text" /> conforms to the pattern of an <c>xs:anyURI</c>.
         /// </summary>
         /// <remarks>
-        /// See: https://www.w3.org/TR/xmlschema11-2/#anyURI and
-        /// https://datatracker.ietf.org/doc/html/rfc3987
+        /// We delegate to <see cref="IriValidation.IsValid" /> instead of the regular
+        /// expression so that the percent-encoding is checked as well.
+        /// The <c>xs:anyURI</c> also allows relative references, hence the lenient mode.
         /// </remarks>
-        /// <param name="text">
-        /// Text to be checked
-        /// </param>
-        /// <returns>
-        /// True if the <paramref name="text" /> conforms to the pattern
-        /// </returns>
         public static bool MatchesXsAnyUri(string text)
         {
-            return RegexMatchesXsAnyUri.IsMatch(text);
+            return IriValidation.IsValid(text, IriValidation.Strictness.Lenient);
         }
 
         [CodeAnalysis.SuppressMessage("ReSharper", "InconsistentNaming")]
@@ -2349,6 +2297,52 @@ namespace AasCore.Aas3_0_RC02
         private class Transformer
             : Visitation.AbstractTransformer<IEnumerable<Reporting.Error>>
         {
+            private readonly Cache? _cache;
+
+            internal Transformer(Cache? cache = null)
+            {
+                _cache = cache;
+            }
+
+            /// <summary>
+            /// Verify <paramref name="that" />, and re-use the results from the cache,
+            /// if any.
+            /// </summary>
+            internal IEnumerable<Reporting.Error> VerifyChild(Aas.IClass that)
+            {
+                if (_cache == null)
+                {
+                    return Transform(that);
+                }
+
+                if (!_cache.Errors.TryGetValue(that, out List<Reporting.Error>? errors))
+                {
+                    errors = new List<Reporting.Error>(Transform(that));
+                    _cache.Errors.AddOrUpdate(that, errors);
+                }
+
+                return CopyErrors(errors);
+            }
+
+            /// <summary>
+            /// Copy the cached <paramref name="errors" /> since the callers prepend
+            /// their path segments to them.
+            /// </summary>
+            private static IEnumerable<Reporting.Error> CopyErrors(
+                List<Reporting.Error> errors)
+            {
+                foreach (var error in errors)
+                {
+                    var copy = new Reporting.Error(error.Cause);
+                    foreach (var segment in error.PathSegments.Reverse())
+                    {
+                        copy.PrependSegment(segment);
+                    }
+
+                    yield return copy;
+                }
+            }
+
             [CodeAnalysis.SuppressMessage("ReSharper", "NegativeEqualityExpression")]
             public override IEnumerable<Reporting.Error> Transform(
                 Aas.Extension that)
@@ -2377,7 +2371,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2391,7 +2385,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2440,7 +2434,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.RefersTo != null)
                 {
-                    foreach (var error in Verification.Verify(that.RefersTo))
+                    foreach (var error in VerifyChild(that.RefersTo))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2490,7 +2484,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2557,7 +2551,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2571,7 +2565,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2628,7 +2622,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.ValueId != null)
                 {
-                    foreach (var error in Verification.Verify(that.ValueId))
+                    foreach (var error in VerifyChild(that.ValueId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2706,7 +2700,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2744,7 +2738,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2755,7 +2749,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2777,7 +2771,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Administration != null)
                 {
-                    foreach (var error in Verification.Verify(that.Administration))
+                    foreach (var error in VerifyChild(that.Administration))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2799,7 +2793,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2815,7 +2809,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DerivedFrom != null)
                 {
-                    foreach (var error in Verification.Verify(that.DerivedFrom))
+                    foreach (var error in VerifyChild(that.DerivedFrom))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2824,7 +2818,7 @@ namespace AasCore.Aas3_0_RC02
                     }
                 }
 
-                foreach (var error in Verification.Verify(that.AssetInformation))
+                foreach (var error in VerifyChild(that.AssetInformation))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -2837,7 +2831,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSubmodels = 0;
                     foreach (var item in that.Submodels)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2866,7 +2860,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.GlobalAssetId != null)
                 {
-                    foreach (var error in Verification.Verify(that.GlobalAssetId))
+                    foreach (var error in VerifyChild(that.GlobalAssetId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2880,7 +2874,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSpecificAssetIds = 0;
                     foreach (var item in that.SpecificAssetIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2896,7 +2890,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DefaultThumbnail != null)
                 {
-                    foreach (var error in Verification.Verify(that.DefaultThumbnail))
+                    foreach (var error in VerifyChild(that.DefaultThumbnail))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2948,7 +2942,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -2962,7 +2956,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -2992,7 +2986,7 @@ namespace AasCore.Aas3_0_RC02
                     yield return error;
                 }
 
-                foreach (var error in Verification.Verify(that.ExternalSubjectId))
+                foreach (var error in VerifyChild(that.ExternalSubjectId))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -3118,7 +3112,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3156,7 +3150,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3167,7 +3161,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3189,7 +3183,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Administration != null)
                 {
-                    foreach (var error in Verification.Verify(that.Administration))
+                    foreach (var error in VerifyChild(that.Administration))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3222,7 +3216,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3236,7 +3230,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3255,7 +3249,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3274,7 +3268,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3293,7 +3287,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSubmodelElements = 0;
                     foreach (var item in that.SubmodelElements)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3396,7 +3390,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3434,7 +3428,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3445,7 +3439,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3481,7 +3475,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3495,7 +3489,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3514,7 +3508,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3533,7 +3527,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3547,7 +3541,7 @@ namespace AasCore.Aas3_0_RC02
                     }
                 }
 
-                foreach (var error in Verification.Verify(that.First))
+                foreach (var error in VerifyChild(that.First))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -3555,7 +3549,7 @@ namespace AasCore.Aas3_0_RC02
                     yield return error;
                 }
 
-                foreach (var error in Verification.Verify(that.Second))
+                foreach (var error in VerifyChild(that.Second))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -3760,7 +3754,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3798,7 +3792,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3809,7 +3803,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3845,7 +3839,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -3859,7 +3853,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3878,7 +3872,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3897,7 +3891,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3916,7 +3910,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexValue = 0;
                     foreach (var item in that.Value)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -3932,7 +3926,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticIdListElement != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticIdListElement))
+                    foreach (var error in VerifyChild(that.SemanticIdListElement))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4081,7 +4075,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4119,7 +4113,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4130,7 +4124,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4166,7 +4160,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4180,7 +4174,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4199,7 +4193,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4218,7 +4212,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4237,7 +4231,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexValue = 0;
                     foreach (var item in that.Value)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4362,7 +4356,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4400,7 +4394,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4411,7 +4405,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4447,7 +4441,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4461,7 +4455,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4480,7 +4474,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4499,7 +4493,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4534,7 +4528,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.ValueId != null)
                 {
-                    foreach (var error in Verification.Verify(that.ValueId))
+                    foreach (var error in VerifyChild(that.ValueId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4644,7 +4638,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4682,7 +4676,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4693,7 +4687,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4729,7 +4723,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4743,7 +4737,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4762,7 +4756,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4781,7 +4775,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4797,7 +4791,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Value != null)
                 {
-                    foreach (var error in Verification.Verify(that.Value))
+                    foreach (var error in VerifyChild(that.Value))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4808,7 +4802,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.ValueId != null)
                 {
-                    foreach (var error in Verification.Verify(that.ValueId))
+                    foreach (var error in VerifyChild(that.ValueId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4938,7 +4932,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -4976,7 +4970,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -4987,7 +4981,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5023,7 +5017,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5037,7 +5031,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5056,7 +5050,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5075,7 +5069,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5220,7 +5214,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5258,7 +5252,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5269,7 +5263,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5305,7 +5299,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5319,7 +5313,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5338,7 +5332,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5357,7 +5351,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5373,7 +5367,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Value != null)
                 {
-                    foreach (var error in Verification.Verify(that.Value))
+                    foreach (var error in VerifyChild(that.Value))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5483,7 +5477,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5521,7 +5515,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5532,7 +5526,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5568,7 +5562,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5582,7 +5576,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5601,7 +5595,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5620,7 +5614,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5754,7 +5748,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5792,7 +5786,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5803,7 +5797,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5839,7 +5833,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -5853,7 +5847,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5872,7 +5866,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -5891,7 +5885,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6013,7 +6007,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6051,7 +6045,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6062,7 +6056,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6098,7 +6092,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6112,7 +6106,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6131,7 +6125,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6150,7 +6144,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6164,7 +6158,7 @@ namespace AasCore.Aas3_0_RC02
                     }
                 }
 
-                foreach (var error in Verification.Verify(that.First))
+                foreach (var error in VerifyChild(that.First))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -6172,7 +6166,7 @@ namespace AasCore.Aas3_0_RC02
                     yield return error;
                 }
 
-                foreach (var error in Verification.Verify(that.Second))
+                foreach (var error in VerifyChild(that.Second))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -6185,7 +6179,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexAnnotations = 0;
                     foreach (var item in that.Annotations)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6331,7 +6325,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6369,7 +6363,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6380,7 +6374,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6416,7 +6410,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6430,7 +6424,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6449,7 +6443,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6468,7 +6462,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6487,7 +6481,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexStatements = 0;
                     foreach (var item in that.Statements)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6511,7 +6505,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.GlobalAssetId != null)
                 {
-                    foreach (var error in Verification.Verify(that.GlobalAssetId))
+                    foreach (var error in VerifyChild(that.GlobalAssetId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6522,7 +6516,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SpecificAssetId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SpecificAssetId))
+                    foreach (var error in VerifyChild(that.SpecificAssetId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6552,7 +6546,7 @@ namespace AasCore.Aas3_0_RC02
                         "Verification.IsModelReferenceToReferable(that.ObservableReference)");
                 }
 
-                foreach (var error in Verification.Verify(that.Source))
+                foreach (var error in VerifyChild(that.Source))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -6562,7 +6556,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SourceSemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SourceSemanticId))
+                    foreach (var error in VerifyChild(that.SourceSemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6571,7 +6565,7 @@ namespace AasCore.Aas3_0_RC02
                     }
                 }
 
-                foreach (var error in Verification.Verify(that.ObservableReference))
+                foreach (var error in VerifyChild(that.ObservableReference))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -6581,7 +6575,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.ObservableSemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.ObservableSemanticId))
+                    foreach (var error in VerifyChild(that.ObservableSemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6603,7 +6597,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SubjectId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SubjectId))
+                    foreach (var error in VerifyChild(that.SubjectId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6749,7 +6743,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6787,7 +6781,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6798,7 +6792,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6834,7 +6828,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -6848,7 +6842,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6867,7 +6861,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6886,7 +6880,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -6900,7 +6894,7 @@ namespace AasCore.Aas3_0_RC02
                     }
                 }
 
-                foreach (var error in Verification.Verify(that.Observed))
+                foreach (var error in VerifyChild(that.Observed))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -6937,7 +6931,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.MessageBroker != null)
                 {
-                    foreach (var error in Verification.Verify(that.MessageBroker))
+                    foreach (var error in VerifyChild(that.MessageBroker))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7068,7 +7062,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7106,7 +7100,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7117,7 +7111,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7153,7 +7147,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7167,7 +7161,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7186,7 +7180,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7205,7 +7199,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7224,7 +7218,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexInputVariables = 0;
                     foreach (var item in that.InputVariables)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7243,7 +7237,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexOutputVariables = 0;
                     foreach (var item in that.OutputVariables)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7262,7 +7256,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexInoutputVariables = 0;
                     foreach (var item in that.InoutputVariables)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7281,7 +7275,7 @@ namespace AasCore.Aas3_0_RC02
             public override IEnumerable<Reporting.Error> Transform(
                 Aas.OperationVariable that)
             {
-                foreach (var error in Verification.Verify(that.Value))
+                foreach (var error in VerifyChild(that.Value))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -7378,7 +7372,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7416,7 +7410,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7427,7 +7421,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7463,7 +7457,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.SemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.SemanticId))
+                    foreach (var error in VerifyChild(that.SemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7477,7 +7471,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSupplementalSemanticIds = 0;
                     foreach (var item in that.SupplementalSemanticIds)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7496,7 +7490,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexQualifiers = 0;
                     foreach (var item in that.Qualifiers)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7515,7 +7509,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7583,7 +7577,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexExtensions = 0;
                     foreach (var item in that.Extensions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7621,7 +7615,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.DisplayName != null)
                 {
-                    foreach (var error in Verification.Verify(that.DisplayName))
+                    foreach (var error in VerifyChild(that.DisplayName))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7632,7 +7626,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7654,7 +7648,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Administration != null)
                 {
-                    foreach (var error in Verification.Verify(that.Administration))
+                    foreach (var error in VerifyChild(that.Administration))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7676,7 +7670,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexDataSpecifications = 0;
                     foreach (var item in that.DataSpecifications)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7695,7 +7689,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexIsCaseOf = 0;
                     foreach (var item in that.IsCaseOf)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -7929,7 +7923,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.ReferredSemanticId != null)
                 {
-                    foreach (var error in Verification.Verify(that.ReferredSemanticId))
+                    foreach (var error in VerifyChild(that.ReferredSemanticId))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -7941,7 +7935,7 @@ namespace AasCore.Aas3_0_RC02
                 int indexKeys = 0;
                 foreach (var item in that.Keys)
                 {
-                    foreach (var error in Verification.Verify(item))
+                    foreach (var error in VerifyChild(item))
                     {
                         error.PrependSegment(
                             new Reporting.IndexSegment(
@@ -8011,7 +8005,7 @@ namespace AasCore.Aas3_0_RC02
                 int indexLangStrings = 0;
                 foreach (var item in that.LangStrings)
                 {
-                    foreach (var error in Verification.Verify(item))
+                    foreach (var error in VerifyChild(item))
                     {
                         error.PrependSegment(
                             new Reporting.IndexSegment(
@@ -8045,7 +8039,7 @@ namespace AasCore.Aas3_0_RC02
                     yield return error;
                 }
 
-                foreach (var error in Verification.Verify(that.DataSpecificationContent))
+                foreach (var error in VerifyChild(that.DataSpecificationContent))
                 {
                     error.PrependSegment(
                         new Reporting.NameSegment(
@@ -8055,7 +8049,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Administration != null)
                 {
-                    foreach (var error in Verification.Verify(that.Administration))
+                    foreach (var error in VerifyChild(that.Administration))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -8066,7 +8060,7 @@ namespace AasCore.Aas3_0_RC02
 
                 if (that.Description != null)
                 {
-                    foreach (var error in Verification.Verify(that.Description))
+                    foreach (var error in VerifyChild(that.Description))
                     {
                         error.PrependSegment(
                             new Reporting.NameSegment(
@@ -8085,7 +8079,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexAssetAdministrationShells = 0;
                     foreach (var item in that.AssetAdministrationShells)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -8104,7 +8098,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexSubmodels = 0;
                     foreach (var item in that.Submodels)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -8123,7 +8117,7 @@ namespace AasCore.Aas3_0_RC02
                     int indexConceptDescriptions = 0;
                     foreach (var item in that.ConceptDescriptions)
                     {
-                        foreach (var error in Verification.Verify(item))
+                        foreach (var error in VerifyChild(item))
                         {
                             error.PrependSegment(
                                 new Reporting.IndexSegment(
@@ -8139,6 +8133,37 @@ namespace AasCore.Aas3_0_RC02
             }
         }  // private class Transformer
 
+        /// <summary>
+        /// Memoize the verification results of the instances which did not change.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The instances are keyed by their identity and held weakly so that
+        /// the cache does not keep them alive.
+        /// </para>
+        /// <para>
+        /// The cache does <em>not</em> observe the modifications of the instances.
+        /// The results of a modified instance and all its ancestors are stale until
+        /// you <see cref="Invalidate" /> each one of them.
+        /// </para>
+        /// <para>
+        /// The cache can be shared between the threads.
+        /// </para>
+        /// </remarks>
+        public class Cache
+        {
+            internal readonly System.Runtime.CompilerServices.ConditionalWeakTable<
+                Aas.IClass, List<Reporting.Error>> Errors = new();
+
+            /// <summary>
+            /// Forget the verification results of <paramref name="that" />.
+            /// </summary>
+            public void Invalidate(Aas.IClass that)
+            {
+                Errors.Remove(that);
+            }
+        }  // public class Cache
+
         /// <summary>
         /// Verify the constraints of <paramref name="that" /> recursively.
         /// </summary>
@@ -8153,6 +8178,59 @@ namespace AasCore.Aas3_0_RC02
             }
         }
 
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" /> recursively, and
+        /// re-use the results of the unchanged descendants from <paramref name="cache" />.
+        /// </summary>
+        /// <remarks>
+        /// The results of the modified instances are stale unless you invalidate them,
+        /// see <see cref="Cache" />.
+        /// </remarks>
+        public static List<Reporting.Error> Verify(Aas.IClass that, Cache cache)
+        {
+            return new List<Reporting.Error>(
+                new Verification.Transformer(cache).VerifyChild(that));
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="instances" /> recursively
+        /// and concurrently.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// Use this function to verify large collections on multi-core machines,
+        /// e.g., all the instances de-serialized from a large file.
+        /// </para>
+        /// <para>
+        /// The errors are merged deterministically in the order of
+        /// <paramref name="instances" />, and the index of the instance is prepended
+        /// to the path of each error.
+        /// </para>
+        /// </remarks>
+        public static List<Reporting.Error> VerifyInParallel(
+            IReadOnlyList<Aas.IClass> instances)
+        {
+            var errorsByIndex = new List<Reporting.Error>[instances.Count];
+
+            System.Threading.Tasks.Parallel.For(
+                0,
+                instances.Count,
+                i => errorsByIndex[i] = new List<Reporting.Error>(
+                    Verify(instances[i])));
+
+            var result = new List<Reporting.Error>();
+            for (int i = 0; i < errorsByIndex.Length; i++)
+            {
+                foreach (var error in errorsByIndex[i])
+                {
+                    error.PrependSegment(new Reporting.IndexSegment(i));
+                    result.Add(error);
+                }
+            }
+
+            return result;
+        }
+
         /// <summary>
         /// Verify the constraints of <paramref name="that" />.
         /// </summary>
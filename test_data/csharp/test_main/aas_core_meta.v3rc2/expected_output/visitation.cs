@@ -65,288 +65,1190 @@ namespace AasCore.Aas3_0_RC02
             public virtual void Visit(Extension that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.SemanticId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.RefersTo != null)
+                {
+                    Visit((IClass)that.RefersTo);
                 }
             }
 
             public virtual void Visit(AdministrativeInformation that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.DataSpecifications != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(Qualifier that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.SemanticId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.ValueId != null)
+                {
+                    Visit((IClass)that.ValueId);
                 }
             }
 
             public virtual void Visit(AssetAdministrationShell that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.Administration != null)
+                {
+                    Visit((IClass)that.Administration);
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DerivedFrom != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.DerivedFrom);
+                }
+
+                Visit((IClass)that.AssetInformation);
+
+                if (that.Submodels != null)
+                {
+                    foreach (var anItem in that.Submodels)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(AssetInformation that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.GlobalAssetId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.GlobalAssetId);
+                }
+
+                if (that.SpecificAssetIds != null)
+                {
+                    foreach (var anItem in that.SpecificAssetIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DefaultThumbnail != null)
+                {
+                    Visit((IClass)that.DefaultThumbnail);
                 }
             }
 
             public virtual void Visit(Resource that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
-                {
-                    Visit(something);
-                }
             }
 
             public virtual void Visit(SpecificAssetId that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.SemanticId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.SemanticId);
                 }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                Visit((IClass)that.ExternalSubjectId);
             }
 
             public virtual void Visit(Submodel that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.Administration != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.Administration);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.SubmodelElements != null)
+                {
+                    foreach (var anItem in that.SubmodelElements)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(RelationshipElement that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
+
+                Visit((IClass)that.First);
+
+                Visit((IClass)that.Second);
             }
 
             public virtual void Visit(SubmodelElementList that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Value != null)
+                {
+                    foreach (var anItem in that.Value)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.SemanticIdListElement != null)
+                {
+                    Visit((IClass)that.SemanticIdListElement);
                 }
             }
 
             public virtual void Visit(SubmodelElementCollection that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Value != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.Value)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(Property that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.ValueId != null)
+                {
+                    Visit((IClass)that.ValueId);
                 }
             }
 
             public virtual void Visit(MultiLanguageProperty that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Value != null)
+                {
+                    Visit((IClass)that.Value);
+                }
+
+                if (that.ValueId != null)
+                {
+                    Visit((IClass)that.ValueId);
                 }
             }
 
             public virtual void Visit(Range that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(ReferenceElement that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Value != null)
+                {
+                    Visit((IClass)that.Value);
                 }
             }
 
             public virtual void Visit(Blob that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(File that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(AnnotatedRelationshipElement that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                Visit((IClass)that.First);
+
+                Visit((IClass)that.Second);
+
+                if (that.Annotations != null)
+                {
+                    foreach (var anItem in that.Annotations)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(Entity that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Statements != null)
+                {
+                    foreach (var anItem in that.Statements)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.GlobalAssetId != null)
+                {
+                    Visit((IClass)that.GlobalAssetId);
+                }
+
+                if (that.SpecificAssetId != null)
+                {
+                    Visit((IClass)that.SpecificAssetId);
                 }
             }
 
             public virtual void Visit(EventPayload that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                Visit((IClass)that.Source);
+
+                if (that.SourceSemanticId != null)
+                {
+                    Visit((IClass)that.SourceSemanticId);
+                }
+
+                Visit((IClass)that.ObservableReference);
+
+                if (that.ObservableSemanticId != null)
+                {
+                    Visit((IClass)that.ObservableSemanticId);
+                }
+
+                if (that.SubjectId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.SubjectId);
                 }
             }
 
             public virtual void Visit(BasicEventElement that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                Visit((IClass)that.Observed);
+
+                if (that.MessageBroker != null)
+                {
+                    Visit((IClass)that.MessageBroker);
                 }
             }
 
             public virtual void Visit(Operation that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.InputVariables != null)
+                {
+                    foreach (var anItem in that.InputVariables)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.OutputVariables != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.OutputVariables)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.InoutputVariables != null)
+                {
+                    foreach (var anItem in that.InoutputVariables)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(OperationVariable that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
-                {
-                    Visit(something);
-                }
+                Visit((IClass)that.Value);
             }
 
             public virtual void Visit(Capability that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.SemanticId != null)
+                {
+                    Visit((IClass)that.SemanticId);
+                }
+
+                if (that.SupplementalSemanticIds != null)
+                {
+                    foreach (var anItem in that.SupplementalSemanticIds)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Qualifiers != null)
+                {
+                    foreach (var anItem in that.Qualifiers)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(ConceptDescription that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.Extensions != null)
+                {
+                    foreach (var anItem in that.Extensions)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.DisplayName != null)
+                {
+                    Visit((IClass)that.DisplayName);
+                }
+
+                if (that.Description != null)
+                {
+                    Visit((IClass)that.Description);
+                }
+
+                if (that.Administration != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.Administration);
+                }
+
+                if (that.DataSpecifications != null)
+                {
+                    foreach (var anItem in that.DataSpecifications)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.IsCaseOf != null)
+                {
+                    foreach (var anItem in that.IsCaseOf)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
 
             public virtual void Visit(Reference that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.ReferredSemanticId != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.ReferredSemanticId);
+                }
+
+                foreach (var anItem in that.Keys)
+                {
+                    Visit((IClass)anItem);
                 }
             }
 
             public virtual void Visit(Key that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
-                {
-                    Visit(something);
-                }
             }
 
             public virtual void Visit(LangString that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
-                {
-                    Visit(something);
-                }
             }
 
             public virtual void Visit(LangStringSet that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                foreach (var anItem in that.LangStrings)
                 {
-                    Visit(something);
+                    Visit((IClass)anItem);
                 }
             }
 
             public virtual void Visit(DataSpecificationContent that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
-                {
-                    Visit(something);
-                }
             }
 
             public virtual void Visit(DataSpecification that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                Visit((IClass)that.DataSpecificationContent);
+
+                if (that.Administration != null)
+                {
+                    Visit((IClass)that.Administration);
+                }
+
+                if (that.Description != null)
                 {
-                    Visit(something);
+                    Visit((IClass)that.Description);
                 }
             }
 
             public virtual void Visit(Environment that)
             {
                 // Just descend through, do nothing with <c>that</c>
-                foreach (var something in that.DescendOnce())
+                if (that.AssetAdministrationShells != null)
+                {
+                    foreach (var anItem in that.AssetAdministrationShells)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.Submodels != null)
+                {
+                    foreach (var anItem in that.Submodels)
+                    {
+                        Visit((IClass)anItem);
+                    }
+                }
+
+                if (that.ConceptDescriptions != null)
                 {
-                    Visit(something);
+                    foreach (var anItem in that.ConceptDescriptions)
+                    {
+                        Visit((IClass)anItem);
+                    }
                 }
             }
         }  // public class VisitorThrough
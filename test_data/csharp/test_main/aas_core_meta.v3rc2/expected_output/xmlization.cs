@@ -66,6 +66,20 @@ namespace AasCore.Aas3_0_RC02
                 return stream.ToArray();
             }
 
+            /// <summary>
+            /// Read the content as a string and intern it with
+            /// <see cref="Deserialize.Intern" />, if set.
+            /// </summary>
+            private static string ReadContentAsInternedString(
+                Xml.XmlReader reader)
+            {
+                string result = reader.ReadContentAsString();
+                var intern = Deserialize.Intern;
+                return (intern != null)
+                    ? intern(result)
+                    : result;
+            }
+
             /// <summary>
             /// Check the namespace and extract the element's name.
             /// </summary>
@@ -323,7 +337,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theName = reader.ReadContentAsString();
+                                        theName = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -418,7 +433,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -1166,7 +1182,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theVersion = reader.ReadContentAsString();
+                                        theVersion = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -1206,7 +1223,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theRevision = reader.ReadContentAsString();
+                                        theRevision = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -1655,7 +1673,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theType = reader.ReadContentAsString();
+                                        theType = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -1750,7 +1769,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -2083,7 +2103,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -2123,7 +2144,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -2191,7 +2213,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -2245,7 +2268,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theId = reader.ReadContentAsString();
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -2961,7 +2985,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        thePath = reader.ReadContentAsString();
+                                        thePath = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3001,7 +3026,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theContentType = reader.ReadContentAsString();
+                                        theContentType = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3312,7 +3338,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theName = reader.ReadContentAsString();
+                                        theName = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3352,7 +3379,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3695,7 +3723,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3735,7 +3764,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3803,7 +3833,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -3857,7 +3888,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theId = reader.ReadContentAsString();
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -4462,7 +4494,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -4502,7 +4535,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -4570,7 +4604,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -5146,7 +5181,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -5186,7 +5222,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -5254,7 +5291,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -5944,7 +5982,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -5984,7 +6023,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -6052,7 +6092,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -6622,7 +6663,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -6662,7 +6704,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -6730,7 +6773,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -6993,7 +7037,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -7324,7 +7369,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -7364,7 +7410,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -7432,7 +7479,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -7935,7 +7983,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -7975,7 +8024,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8043,7 +8093,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8306,7 +8357,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theMin = reader.ReadContentAsString();
+                                        theMin = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8346,7 +8398,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theMax = reader.ReadContentAsString();
+                                        theMax = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8662,7 +8715,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8702,7 +8756,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -8770,7 +8825,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9257,7 +9313,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9297,7 +9354,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9365,7 +9423,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9621,7 +9680,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theContentType = reader.ReadContentAsString();
+                                        theContentType = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9937,7 +9997,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -9977,7 +10038,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10045,7 +10107,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10253,7 +10316,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10293,7 +10357,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theContentType = reader.ReadContentAsString();
+                                        theContentType = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10610,7 +10675,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10650,7 +10716,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -10718,7 +10785,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -11276,7 +11344,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -11316,7 +11385,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -11384,7 +11454,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12004,7 +12075,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theTopic = reader.ReadContentAsString();
+                                        theTopic = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12058,7 +12130,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theTimeStamp = reader.ReadContentAsString();
+                                        theTimeStamp = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12098,7 +12171,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        thePayload = reader.ReadContentAsString();
+                                        thePayload = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12483,7 +12557,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12523,7 +12598,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12591,7 +12667,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12923,7 +13000,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theMessageTopic = reader.ReadContentAsString();
+                                        theMessageTopic = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -12977,7 +13055,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theLastUpdate = reader.ReadContentAsString();
+                                        theLastUpdate = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -13017,7 +13096,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theMinInterval = reader.ReadContentAsString();
+                                        theMinInterval = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -13057,7 +13137,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theMaxInterval = reader.ReadContentAsString();
+                                        theMaxInterval = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -13400,7 +13481,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -13440,7 +13522,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -13508,7 +13591,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -14331,7 +14415,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -14371,7 +14456,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -14439,7 +14525,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -14908,7 +14995,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theCategory = reader.ReadContentAsString();
+                                        theCategory = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -14948,7 +15036,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theIdShort = reader.ReadContentAsString();
+                                        theIdShort = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -15016,7 +15105,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theChecksum = reader.ReadContentAsString();
+                                        theChecksum = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -15070,7 +15160,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theId = reader.ReadContentAsString();
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -15795,7 +15886,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theValue = reader.ReadContentAsString();
+                                        theValue = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -16066,7 +16158,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theLanguage = reader.ReadContentAsString();
+                                        theLanguage = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -16106,7 +16199,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theText = reader.ReadContentAsString();
+                                        theText = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -16749,7 +16843,8 @@ namespace AasCore.Aas3_0_RC02
 
                                     try
                                     {
-                                        theId = reader.ReadContentAsString();
+                                        theId = DeserializeImplementation.ReadContentAsInternedString(
+                                        reader);
                                     }
                                     catch (System.Exception exception)
                                     {
@@ -17350,6 +17445,16 @@ namespace AasCore.Aas3_0_RC02
         /// </example>
         public static class Deserialize
         {
+            /// <summary>
+            /// If set, intern the deserialized strings with this function.
+            /// </summary>
+            /// <remarks>
+            /// Large data sets often repeat the same strings. Plug in, for example,
+            /// <see cref="string.Intern" /> or your own pool so that all the occurrences
+            /// of a string share a single instance.
+            /// </remarks>
+            public static System.Func<string, string>? Intern { get; set; }
+
             /// <summary>
             /// Deserialize an instance of IHasSemantics from <paramref name="reader" />.
             /// </summary>
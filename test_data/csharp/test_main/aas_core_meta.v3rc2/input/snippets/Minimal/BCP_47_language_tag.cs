@@ -0,0 +1 @@
+"en-GB"
@@ -0,0 +1 @@
+"text/plain"
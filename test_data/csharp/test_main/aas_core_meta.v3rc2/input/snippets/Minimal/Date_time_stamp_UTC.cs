@@ -0,0 +1 @@
+"2022-04-01T01:02:03Z"
@@ -0,0 +1,12 @@
+/// <summary>
+/// Check that <paramref name="text" /> conforms to the pattern of an <c>xs:anyURI</c>.
+/// </summary>
+/// <remarks>
+/// We delegate to <see cref="IriValidation.IsValid" /> instead of the regular
+/// expression so that the percent-encoding is checked as well.
+/// The <c>xs:anyURI</c> also allows relative references, hence the lenient mode.
+/// </remarks>
+public static bool MatchesXsAnyUri(string text)
+{
+    return IriValidation.IsValid(text, IriValidation.Strictness.Lenient);
+}
@@ -49,9 +49,84 @@ namespace dummyNamespace
         private class Transformer
             : Visitation.AbstractTransformer<IEnumerable<Reporting.Error>>
         {
+            private readonly Cache? _cache;
 
+            internal Transformer(Cache? cache = null)
+            {
+                _cache = cache;
+            }
+
+            /// <summary>
+            /// Verify <paramref name="that" />, and re-use the results from the cache,
+            /// if any.
+            /// </summary>
+            internal IEnumerable<Reporting.Error> VerifyChild(Aas.IClass that)
+            {
+                if (_cache == null)
+                {
+                    return Transform(that);
+                }
+
+                if (!_cache.Errors.TryGetValue(that, out List<Reporting.Error>? errors))
+                {
+                    errors = new List<Reporting.Error>(Transform(that));
+                    _cache.Errors.AddOrUpdate(that, errors);
+                }
+
+                return CopyErrors(errors);
+            }
+
+            /// <summary>
+            /// Copy the cached <paramref name="errors" /> since the callers prepend
+            /// their path segments to them.
+            /// </summary>
+            private static IEnumerable<Reporting.Error> CopyErrors(
+                List<Reporting.Error> errors)
+            {
+                foreach (var error in errors)
+                {
+                    var copy = new Reporting.Error(error.Cause);
+                    foreach (var segment in error.PathSegments.Reverse())
+                    {
+                        copy.PrependSegment(segment);
+                    }
+
+                    yield return copy;
+                }
+            }
         }  // private class Transformer
 
+        /// <summary>
+        /// Memoize the verification results of the instances which did not change.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// The instances are keyed by their identity and held weakly so that
+        /// the cache does not keep them alive.
+        /// </para>
+        /// <para>
+        /// The cache does <em>not</em> observe the modifications of the instances.
+        /// The results of a modified instance and all its ancestors are stale until
+        /// you <see cref="Invalidate" /> each one of them.
+        /// </para>
+        /// <para>
+        /// The cache can be shared between the threads.
+        /// </para>
+        /// </remarks>
+        public class Cache
+        {
+            internal readonly System.Runtime.CompilerServices.ConditionalWeakTable<
+                Aas.IClass, List<Reporting.Error>> Errors = new();
+
+            /// <summary>
+            /// Forget the verification results of <paramref name="that" />.
+            /// </summary>
+            public void Invalidate(Aas.IClass that)
+            {
+                Errors.Remove(that);
+            }
+        }  // public class Cache
+
         /// <summary>
         /// Verify the constraints of <paramref name="that" /> recursively.
         /// </summary>
@@ -65,6 +140,59 @@ namespace dummyNamespace
                 yield return error;
             }
         }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="that" /> recursively, and
+        /// re-use the results of the unchanged descendants from <paramref name="cache" />.
+        /// </summary>
+        /// <remarks>
+        /// The results of the modified instances are stale unless you invalidate them,
+        /// see <see cref="Cache" />.
+        /// </remarks>
+        public static List<Reporting.Error> Verify(Aas.IClass that, Cache cache)
+        {
+            return new List<Reporting.Error>(
+                new Verification.Transformer(cache).VerifyChild(that));
+        }
+
+        /// <summary>
+        /// Verify the constraints of <paramref name="instances" /> recursively
+        /// and concurrently.
+        /// </summary>
+        /// <remarks>
+        /// <para>
+        /// Use this function to verify large collections on multi-core machines,
+        /// e.g., all the instances de-serialized from a large file.
+        /// </para>
+        /// <para>
+        /// The errors are merged deterministically in the order of
+        /// <paramref name="instances" />, and the index of the instance is prepended
+        /// to the path of each error.
+        /// </para>
+        /// </remarks>
+        public static List<Reporting.Error> VerifyInParallel(
+            IReadOnlyList<Aas.IClass> instances)
+        {
+            var errorsByIndex = new List<Reporting.Error>[instances.Count];
+
+            System.Threading.Tasks.Parallel.For(
+                0,
+                instances.Count,
+                i => errorsByIndex[i] = new List<Reporting.Error>(
+                    Verify(instances[i])));
+
+            var result = new List<Reporting.Error>();
+            for (int i = 0; i < errorsByIndex.Length; i++)
+            {
+                foreach (var error in errorsByIndex[i])
+                {
+                    error.PrependSegment(new Reporting.IndexSegment(i));
+                    result.Add(error);
+                }
+            }
+
+            return result;
+        }
     }  // public static class Verification
 }  // namespace dummyNamespace
 
@@ -0,0 +1,8 @@
+@access_controlled("secret")
+class Something:
+    secret: Optional[str]
+    public: Optional[str]
+
+
+__book_url__ = "dummy"
+__book_version__ = "dummy"
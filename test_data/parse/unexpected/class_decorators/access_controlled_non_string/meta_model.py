@@ -0,0 +1,7 @@
+@access_controlled(secret)
+class Something:
+    secret: Optional[str]
+
+
+__book_url__ = "dummy"
+__book_version__ = "dummy"
@@ -0,0 +1,83 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import io
+import pathlib
+import tempfile
+import unittest
+
+from aas_core_codegen.api_compat import main as api_compat_main
+from aas_core_codegen.csharp import public_api as csharp_public_api
+
+
+class Test_execute(unittest.TestCase):
+    def test_breaking_and_compatible(self) -> None:
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            old_path = pathlib.Path(tmp_dir) / "old.json"
+            old_path.write_text(
+                csharp_public_api.dump(
+                    {"Something": "class", "Something.SomeProperty": "string?"}
+                ),
+                encoding="utf-8",
+            )
+
+            compatible_path = pathlib.Path(tmp_dir) / "compatible.json"
+            compatible_path.write_text(
+                csharp_public_api.dump(
+                    {
+                        "Something": "class",
+                        "Something.SomeProperty": "string?",
+                        "Something.OtherProperty": "int?",
+                    }
+                ),
+                encoding="utf-8",
+            )
+
+            breaking_path = pathlib.Path(tmp_dir) / "breaking.json"
+            breaking_path.write_text(
+                csharp_public_api.dump({"Something": "class"}), encoding="utf-8"
+            )
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+            return_code = api_compat_main.execute(
+                old_path=old_path,
+                new_path=compatible_path,
+                allow_breaking=False,
+                stdout=stdout,
+                stderr=stderr,
+            )
+            self.assertEqual(0, return_code, stderr.getvalue())
+            self.assertEqual(
+                "added: Something.OtherProperty: int?\n", stdout.getvalue()
+            )
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+            return_code = api_compat_main.execute(
+                old_path=old_path,
+                new_path=breaking_path,
+                allow_breaking=False,
+                stdout=stdout,
+                stderr=stderr,
+            )
+            self.assertEqual(1, return_code)
+            self.assertEqual(
+                "BREAKING removed: Something.SomeProperty: string?\n",
+                stdout.getvalue(),
+            )
+            self.assertIn("1 breaking change(s)", stderr.getvalue())
+
+            return_code = api_compat_main.execute(
+                old_path=old_path,
+                new_path=breaking_path,
+                allow_breaking=True,
+                stdout=io.StringIO(),
+                stderr=io.StringIO(),
+            )
+            self.assertEqual(0, return_code)
+
+
+if __name__ == "__main__":
+    unittest.main()
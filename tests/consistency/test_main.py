@@ -0,0 +1,100 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import io
+import os
+import pathlib
+import textwrap
+import unittest
+import xml.etree.ElementTree as ET
+
+import aas_core_meta.v3rc2
+
+from aas_core_codegen.consistency import main as consistency_main
+
+
+class Test_observe(unittest.TestCase):
+    def test_shacl(self) -> None:
+        text = textwrap.dedent(
+            """\
+            aas:SomethingShape a sh:NodeShape ;
+                sh:property [
+                    a sh:PropertyShape ;
+                    sh:path <https://example.com/Something/version> ;
+                    sh:pattern "^[0-9]+$" ;
+                ] ;
+                sh:property [
+                    a sh:PropertyShape ;
+                    sh:path <https://example.com/Something/revision> ;
+                ] ;
+            .
+            """
+        )
+
+        # pylint: disable=protected-access
+        observation = consistency_main._observe_shacl(text)
+
+        self.assertDictEqual(
+            {("Something", "version"): 1, ("Something", "revision"): 0},
+            dict(observation),
+        )
+
+    def test_xsd(self) -> None:
+        text = textwrap.dedent(
+            """\
+            <xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+              <xs:group name="something">
+                <xs:sequence>
+                  <xs:group ref="somethingAbstract"/>
+                  <xs:element name="version">
+                    <xs:simpleType>
+                      <xs:restriction base="xs:string">
+                        <xs:pattern value="[0-9]+"/>
+                      </xs:restriction>
+                    </xs:simpleType>
+                  </xs:element>
+                </xs:sequence>
+              </xs:group>
+            </xs:schema>
+            """
+        )
+
+        # pylint: disable=protected-access
+        observation = consistency_main._observe_xsd(ET.fromstring(text))
+
+        self.assertDictEqual({("something", "version"): 1}, dict(observation))
+
+
+class Test_against_recorded(unittest.TestCase):
+    def test_v3rc2(self) -> None:
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        def expected_output_dir(target: str) -> pathlib.Path:
+            return (
+                repo_dir
+                / "test_data"
+                / target
+                / "test_main"
+                / "aas_core_meta.v3rc2"
+                / "expected_output"
+            )
+
+        stdout = io.StringIO()
+        stderr = io.StringIO()
+
+        return_code = consistency_main.execute(
+            model_path=pathlib.Path(aas_core_meta.v3rc2.__file__),
+            jsonschema_path=expected_output_dir("jsonschema") / "schema.json",
+            xsd_path=expected_output_dir("xsd") / "schema.xsd",
+            shacl_path=expected_output_dir("rdf_shacl") / "shacl-schema.ttl",
+            csharp_verification_path=expected_output_dir("csharp") / "verification.cs",
+            stdout=stdout,
+            stderr=stderr,
+        )
+
+        self.assertEqual(0, return_code, stderr.getvalue())
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,165 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+from typing import List, Tuple
+
+import asttokens
+
+import tests.common
+from aas_core_codegen import intermediate, parse
+from aas_core_codegen.constraints_report import main as constraints_report_main
+
+
+def translate(source: str) -> Tuple[intermediate.SymbolTable, asttokens.ASTTokens]:
+    """Translate the ``source`` to the intermediate symbol table."""
+    atok, parse_exception = parse.source_to_atok(source=source)
+    assert parse_exception is None, parse_exception
+    assert atok is not None
+
+    parsed_symbol_table, error = tests.common.parse_atok(atok=atok)
+    assert error is None, tests.common.most_underlying_messages(error)
+    assert parsed_symbol_table is not None
+
+    symbol_table, error = intermediate.translate(
+        parsed_symbol_table=parsed_symbol_table, atok=atok
+    )
+    assert error is None, tests.common.most_underlying_messages(error)
+    assert symbol_table is not None
+
+    return symbol_table, atok
+
+
+def report_statuses(source: str) -> List[Tuple[str, str, bool, str, str]]:
+    """
+    Generate the report for ``source`` and flatten it.
+
+    Each invariant is given as its class, the class which specifies it, whether it is
+    inherited, and its C# and JSON Schema status.
+    """
+    symbol_table, atok = translate(source)
+
+    report = constraints_report_main.generate_report(
+        symbol_table=symbol_table, atok=atok
+    )
+
+    return [
+        (
+            cls["name"],
+            invariant["specifiedFor"],
+            invariant["inherited"],
+            invariant["statusByTarget"]["csharp"],
+            invariant["statusByTarget"]["jsonschema"],
+        )
+        for cls in report["classes"]
+        for invariant in cls["invariants"]
+    ]
+
+
+class Test_generate_report(unittest.TestCase):
+    def test_statuses(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            @implementation_specific
+            def is_fancy(text: str) -> bool:
+                pass
+
+
+            @verification
+            def is_very_fancy(text: str) -> bool:
+                return is_fancy(text) and len(text) > 3
+
+
+            @abstract
+            @invariant(lambda self: len(self.some_property) > 0)
+            class Parent:
+                some_property: str
+
+                def __init__(self, some_property: str) -> None:
+                    self.some_property = some_property
+
+
+            @invariant(lambda self: is_very_fancy(self.some_property))
+            class Something(Parent):
+                def __init__(self, some_property: str) -> None:
+                    Parent.__init__(self, some_property)
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        self.assertListEqual(
+            [
+                ("Parent", "Parent", False, "transpiled", "transpiled"),
+                ("Something", "Parent", True, "transpiled", "transpiled"),
+                ("Something", "Something", False, "snippet", "dropped-per-schema"),
+            ],
+            report_statuses(source),
+        )
+
+
+class Test_generate_traceability(unittest.TestCase):
+    def test_constructs(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @invariant(
+                lambda self: len(self) > 0,
+                "Constraint AASd-001: The text must not be empty."
+            )
+            class Non_empty_string(str):
+                pass
+
+
+            @invariant(
+                lambda self: self.some_property != "forbidden",
+                "Constraint AASd-002: The text must not be forbidden."
+            )
+            class Something:
+                some_property: Non_empty_string
+
+                def __init__(self, some_property: Non_empty_string) -> None:
+                    self.some_property = some_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, _ = translate(source)
+
+        traceability = constraints_report_main.generate_traceability(
+            symbol_table=symbol_table
+        )
+
+        self.assertDictEqual(
+            {
+                "AASd-001": [
+                    ("csharp", "Verification.VerifyNonEmptyString"),
+                    ("jsonschema", "#/definitions/Something"),
+                    ("xsd", "xs:group[@name='something']"),
+                    ("rdf_shacl", "aas:SomethingShape"),
+                ],
+                "AASd-002": [
+                    ("csharp", "Verification.Transformer.Transform(Aas.Something)")
+                ],
+            },
+            {
+                identifier: [
+                    (construct["target"], construct["symbol"])
+                    for construct in constructs
+                ]
+                for identifier, constructs in traceability[
+                    "constructsByConstraint"
+                ].items()
+            },
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
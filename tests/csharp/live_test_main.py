@@ -14,6 +14,7 @@ import textwrap
 import aas_core_meta.v3rc2
 
 import aas_core_codegen.main
+from aas_core_codegen import run
 
 
 def main() -> int:
@@ -63,6 +64,8 @@ def main() -> int:
                 target=aas_core_codegen.main.Target.CSHARP,
                 snippets_dir=snippets_dir,
                 output_dir=output_dir,
+                # The snippets delegate to the IRI validation.
+                extras={run.Extra.IRI_VALIDATION},
             )
 
             stdout = io.StringIO()
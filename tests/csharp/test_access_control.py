@@ -0,0 +1,58 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+
+import tests.common
+from aas_core_codegen.csharp import (
+    access_control as csharp_access_control,
+    common as csharp_common,
+)
+
+
+class Test_generate(unittest.TestCase):
+    def test_required_access_controlled_property_fails(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @access_controlled("secret")
+            class Something:
+                secret: str
+
+                def __init__(self, secret: str) -> None:
+                    self.secret = secret
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_access_control.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("Dummy"),
+        )
+
+        self.assertIsNone(code)
+        assert errors is not None
+
+        self.assertListEqual(
+            [
+                "The property 'secret' of the class 'Something' is marked "
+                "as access-controlled, but it is required. Only optional "
+                "properties can be stripped from the instances in the C# "
+                "access control."
+            ],
+            [error.message for error in errors],
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,36 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import unittest
+
+from aas_core_codegen.csharp import compilation as csharp_compilation
+
+
+class Test_map_diagnostics(unittest.TestCase):
+    def test_grouped_by_module(self) -> None:
+        build_output = (
+            "/some/output/types.cs(12,5): error CS1002: ; expected\n"
+            "/some/output/jsonization.cs(3,1): error CS0246: "
+            "The type or namespace name 'Foo' could not be found\n"
+            "/some/output/types.cs(12,5): error CS1002: ; expected\n"
+            "/some/output/types.cs(20,1): warning CS8618: Non-nullable property\n"
+        )
+
+        # pylint: disable=protected-access
+        errors = csharp_compilation._map_diagnostics(build_output)
+
+        self.assertListEqual(
+            [
+                "Generated by aas_core_codegen.csharp.structure:\n"
+                "/some/output/types.cs(12,5): error CS1002: ; expected",
+                "Generated by aas_core_codegen.csharp.jsonization:\n"
+                "/some/output/jsonization.cs(3,1): error CS0246: "
+                "The type or namespace name 'Foo' could not be found",
+            ],
+            errors,
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -262,6 +262,52 @@ class Test_to_render_description_of_our_types(unittest.TestCase):
             comment_code,
         )
 
+    def test_summary_with_constrained_primitive_reference(self) -> None:
+        comment_code = Test_to_render_description_of_our_types.render(
+            textwrap.dedent(
+                '''\
+                class Something(str):
+                    """Do & drink :class:`.Something`."""
+
+                __book_url__ = "dummy"
+                __book_version__ = "dummy"
+                '''
+            )
+        )
+
+        self.assertEqual(
+            textwrap.dedent(
+                """\
+                /// <summary>
+                /// Do &amp; drink <see cref="Aas.Verification.VerifySomething" />.
+                /// </summary>"""
+            ),
+            comment_code,
+        )
+
+    def test_summary_with_hyperlink(self) -> None:
+        comment_code = Test_to_render_description_of_our_types.render(
+            textwrap.dedent(
+                '''\
+                class Something:
+                    """Do & drink `something <https://x.org/?a=1&b=2>`_."""
+
+                __book_url__ = "dummy"
+                __book_version__ = "dummy"
+                '''
+            )
+        )
+
+        self.assertEqual(
+            textwrap.dedent(
+                """\
+                /// <summary>
+                /// Do &amp; drink <a href="https://x.org/?a=1&amp;b=2">something</a>.
+                /// </summary>"""
+            ),
+            comment_code,
+        )
+
     def test_summary_and_remarks(self) -> None:
         comment_code = Test_to_render_description_of_our_types.render(
             textwrap.dedent(
@@ -414,6 +460,43 @@ class Test_to_render_description_of_our_types(unittest.TestCase):
         )
 
 
+    def test_summary_and_example(self) -> None:
+        comment_code = Test_to_render_description_of_our_types.render(
+            textwrap.dedent(
+                '''\
+                class Something:
+                    """
+                    Do & drink something.
+
+                    .. example::
+
+                        Create it like this::
+
+                            something = Something()
+                    """
+
+                __book_url__ = "dummy"
+                __book_version__ = "dummy"
+                '''
+            )
+        )
+
+        self.assertEqual(
+            """\
+/// <summary>
+/// Do &amp; drink something.
+/// </summary>
+/// <example>
+/// <para>
+/// Create it like this:
+/// </para>
+/// <code>
+/// something = Something()
+/// </code>
+/// </example>""",
+            comment_code,
+        )
+
 class Test_to_render_description_of_signature(unittest.TestCase):
     @staticmethod
     def render(source: str) -> Stripped:
@@ -0,0 +1,99 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+from typing import Optional, Tuple, List
+
+import tests.common
+from aas_core_codegen import specific_implementations
+from aas_core_codegen.common import Error, Stripped
+from aas_core_codegen.csharp import (
+    common as csharp_common,
+    digestion as csharp_digestion,
+)
+
+
+class Test_etag_class(unittest.TestCase):
+    @staticmethod
+    def generate_with_etag_class(
+        etag_class: Optional[str],
+    ) -> Tuple[Optional[str], Optional[List[Error]]]:
+        source = textwrap.dedent(
+            """\
+            class Some_enum(Enum):
+                Some_literal = "some-literal"
+
+
+            class Something:
+                some_property: str
+
+                def __init__(self, some_property: str) -> None:
+                    self.some_property = some_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        spec_impls = dict()  # type: specific_implementations.SpecificImplementations
+        if etag_class is not None:
+            spec_impls = {
+                specific_implementations.ImplementationKey(
+                    "Digestion/etag_class.txt"
+                ): Stripped(etag_class)
+            }
+
+        return csharp_digestion.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            spec_impls=spec_impls,
+        )
+
+    def test_no_etag_without_snippet(self) -> None:
+        code, errors = Test_etag_class.generate_with_etag_class(etag_class=None)
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertNotIn("ETag", code)
+
+    def test_etag_of_the_given_class(self) -> None:
+        code, errors = Test_etag_class.generate_with_etag_class(
+            etag_class="Something"
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertIn("public static string ETag(Aas.Something that)", code)
+
+    def test_unknown_class(self) -> None:
+        _, errors = Test_etag_class.generate_with_etag_class(etag_class="Unknown")
+        assert errors is not None
+
+        self.assertEqual(
+            "The class 'Unknown' given in the snippet Digestion/etag_class.txt "
+            "could not be found in the meta-model",
+            tests.common.most_underlying_messages(errors),
+        )
+
+    def test_enumeration(self) -> None:
+        _, errors = Test_etag_class.generate_with_etag_class(etag_class="Some_enum")
+        assert errors is not None
+
+        self.assertEqual(
+            "Expected 'Some_enum' given in the snippet Digestion/etag_class.txt "
+            "to be a class, but got: Enumeration",
+            tests.common.most_underlying_messages(errors),
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,275 @@
+# pylint: disable=missing-docstring
+
+import contextlib
+import io
+import os
+import pathlib
+import shutil
+import subprocess
+import tempfile
+import textwrap
+import unittest
+
+import aas_core_codegen.main
+from aas_core_codegen import run
+from aas_core_codegen.csharp import compilation as csharp_compilation
+
+import tests.common
+
+
+class Test_against_recorded(unittest.TestCase):
+    def test_cases(self) -> None:
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        parent_case_dir = repo_dir / "test_data" / "csharp" / "test_extras"
+        assert parent_case_dir.exists() and parent_case_dir.is_dir(), parent_case_dir
+
+        for case_dir in sorted(pth for pth in parent_case_dir.iterdir()):
+            if not case_dir.is_dir():
+                continue
+
+            model_pth = case_dir / "input/model.py"
+            assert model_pth.exists() and model_pth.is_file(), model_pth
+
+            snippets_dir = case_dir / "input/snippets"
+            assert snippets_dir.exists() and snippets_dir.is_dir(), snippets_dir
+
+            expected_output_dir = case_dir / "expected_output"
+
+            with contextlib.ExitStack() as exit_stack:
+                if tests.common.RERECORD:
+                    output_dir = expected_output_dir
+                    expected_output_dir.mkdir(exist_ok=True, parents=True)
+                else:
+                    assert (
+                        expected_output_dir.exists() and expected_output_dir.is_dir()
+                    ), expected_output_dir
+
+                    # pylint: disable=consider-using-with
+                    tmp_dir = tempfile.TemporaryDirectory()
+                    exit_stack.push(tmp_dir)
+                    output_dir = pathlib.Path(tmp_dir.name)
+
+                # The meta-model is small so that we can record all the extras
+                # without relying on aas-core-meta.
+                params = aas_core_codegen.main.Parameters(
+                    model_path=model_pth,
+                    target=aas_core_codegen.main.Target.CSHARP,
+                    snippets_dir=snippets_dir,
+                    output_dir=output_dir,
+                    extras=set(run.Extra),
+                )
+
+                stdout = io.StringIO()
+                stderr = io.StringIO()
+
+                return_code = aas_core_codegen.main.execute(
+                    params=params, stdout=stdout, stderr=stderr
+                )
+
+                if stderr.getvalue() != "":
+                    raise AssertionError(
+                        f"Expected no stderr on valid models, but got:\n"
+                        f"{stderr.getvalue()}"
+                    )
+
+                self.assertEqual(
+                    0, return_code, "Expected 0 return code on valid models"
+                )
+
+                stdout_pth = expected_output_dir / "stdout.txt"
+                normalized_stdout = stdout.getvalue().replace(
+                    str(output_dir), "<output dir>"
+                )
+
+                if tests.common.RERECORD:
+                    stdout_pth.write_text(normalized_stdout, encoding="utf-8")
+                    continue
+
+                self.assertEqual(
+                    normalized_stdout,
+                    stdout_pth.read_text(encoding="utf-8"),
+                    stdout_pth,
+                )
+
+                expected_rel_pths = sorted(
+                    pth.relative_to(expected_output_dir)
+                    for pth in expected_output_dir.glob("**/*")
+                    if pth.is_file() and pth.name != "stdout.txt"
+                )
+                output_rel_pths = sorted(
+                    pth.relative_to(output_dir)
+                    for pth in output_dir.glob("**/*")
+                    if pth.is_file()
+                )
+
+                self.assertListEqual(expected_rel_pths, output_rel_pths, case_dir)
+
+                for rel_pth in expected_rel_pths:
+                    expected_pth = expected_output_dir / rel_pth
+                    output_pth = output_dir / rel_pth
+
+                    self.assertEqual(
+                        expected_pth.read_text(encoding="utf-8"),
+                        output_pth.read_text(encoding="utf-8"),
+                        f"The files {expected_pth} and {output_pth} do not match.",
+                    )
+
+
+class Test_compilation(unittest.TestCase):
+    def test_cases(self) -> None:
+        if shutil.which("dotnet") is None:
+            self.skipTest("The dotnet executable could not be found.")
+
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        parent_case_dir = repo_dir / "test_data" / "csharp" / "test_extras"
+
+        for case_dir in sorted(pth for pth in parent_case_dir.iterdir()):
+            if not case_dir.is_dir():
+                continue
+
+            with tempfile.TemporaryDirectory() as tmp_dir:
+                output_dir = pathlib.Path(tmp_dir)
+
+                params = aas_core_codegen.main.Parameters(
+                    model_path=case_dir / "input/model.py",
+                    target=aas_core_codegen.main.Target.CSHARP,
+                    snippets_dir=case_dir / "input/snippets",
+                    output_dir=output_dir,
+                    extras=set(run.Extra),
+                )
+
+                stdout = io.StringIO()
+                stderr = io.StringIO()
+
+                return_code = aas_core_codegen.main.execute(
+                    params=params, stdout=stdout, stderr=stderr
+                )
+                self.assertEqual(0, return_code, stderr.getvalue())
+
+                return_code = csharp_compilation.smoke_compile(
+                    output_dir=output_dir,
+                    logger=run.Logger(stream=stdout),
+                    stderr=stderr,
+                )
+                self.assertEqual(0, return_code, stderr.getvalue())
+
+
+class Test_compilation_of_each_extra_alone(unittest.TestCase):
+    """Check that the extras generate the other outputs they build on."""
+
+    def test_cases(self) -> None:
+        if shutil.which("dotnet") is None:
+            self.skipTest("The dotnet executable could not be found.")
+
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        case_dir = repo_dir / "test_data" / "csharp" / "test_extras" / "small_model"
+
+        for extra in run.Extra:
+            with tempfile.TemporaryDirectory() as tmp_dir:
+                output_dir = pathlib.Path(tmp_dir)
+
+                params = aas_core_codegen.main.Parameters(
+                    model_path=case_dir / "input/model.py",
+                    target=aas_core_codegen.main.Target.CSHARP,
+                    snippets_dir=case_dir / "input/snippets",
+                    output_dir=output_dir,
+                    # The snippets of the small model delegate to the IRI validation.
+                    extras={extra, run.Extra.IRI_VALIDATION},
+                )
+
+                stdout = io.StringIO()
+                stderr = io.StringIO()
+
+                return_code = aas_core_codegen.main.execute(
+                    params=params, stdout=stdout, stderr=stderr
+                )
+                self.assertEqual(0, return_code, f"{extra}: {stderr.getvalue()}")
+
+                return_code = csharp_compilation.smoke_compile(
+                    output_dir=output_dir,
+                    logger=run.Logger(stream=stdout),
+                    stderr=stderr,
+                )
+                self.assertEqual(0, return_code, f"{extra}: {stderr.getvalue()}")
+
+
+class Test_checks(unittest.TestCase):
+    """Run the C# checks of the generated code's behavior, if dotnet is available."""
+
+    def test_cases(self) -> None:
+        dotnet = shutil.which("dotnet")
+        if dotnet is None:
+            self.skipTest("The dotnet executable could not be found.")
+
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        parent_case_dir = repo_dir / "test_data" / "csharp" / "test_extras"
+
+        for case_dir in sorted(pth for pth in parent_case_dir.iterdir()):
+            checks_dir = case_dir / "checks"
+            if not checks_dir.is_dir():
+                continue
+
+            with tempfile.TemporaryDirectory() as tmp_dir:
+                output_dir = pathlib.Path(tmp_dir) / "output"
+                output_dir.mkdir()
+
+                params = aas_core_codegen.main.Parameters(
+                    model_path=case_dir / "input/model.py",
+                    target=aas_core_codegen.main.Target.CSHARP,
+                    snippets_dir=case_dir / "input/snippets",
+                    output_dir=output_dir,
+                    extras=set(run.Extra),
+                )
+
+                stdout = io.StringIO()
+                stderr = io.StringIO()
+
+                return_code = aas_core_codegen.main.execute(
+                    params=params, stdout=stdout, stderr=stderr
+                )
+                self.assertEqual(0, return_code, stderr.getvalue())
+
+                project_pth = pathlib.Path(tmp_dir) / "Checks.csproj"
+                project_pth.write_text(
+                    textwrap.dedent(
+                        f"""\
+                        <Project Sdk="Microsoft.NET.Sdk">
+                          <PropertyGroup>
+                            <TargetFramework>net6.0</TargetFramework>
+                            <OutputType>Exe</OutputType>
+                            <Nullable>enable</Nullable>
+                            <LangVersion>10</LangVersion>
+                            <EnableDefaultCompileItems>false</EnableDefaultCompileItems>
+                            <DefineConstants>$(DefineConstants);AAS_CORE_INSTRUMENTATION</DefineConstants>
+                          </PropertyGroup>
+                          <ItemGroup>
+                            <Compile Include="{output_dir.as_posix()}/*.cs" />
+                            <Compile Include="{checks_dir.as_posix()}/*.cs" />
+                          </ItemGroup>
+                        </Project>
+                        """
+                    ),
+                    encoding="utf-8",
+                )
+
+                completed = subprocess.run(
+                    [dotnet, "run", "--project", str(project_pth)],
+                    stdout=subprocess.PIPE,
+                    stderr=subprocess.STDOUT,
+                    encoding="utf-8",
+                    check=False,
+                )
+
+                self.assertEqual(
+                    0,
+                    completed.returncode,
+                    f"The checks in {checks_dir} failed:\n{completed.stdout}",
+                )
+
+
+if __name__ == "__main__":
+    unittest.main()
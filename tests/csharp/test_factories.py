@@ -0,0 +1,248 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import re
+import textwrap
+import unittest
+
+import tests.common
+from aas_core_codegen import infer_for_schema, specific_implementations
+from aas_core_codegen.common import Stripped
+from aas_core_codegen.csharp import (
+    common as csharp_common,
+    factories as csharp_factories,
+)
+
+
+class Test_generate_text(unittest.TestCase):
+    def test_without_constraints(self) -> None:
+        # pylint: disable=protected-access
+        self.assertEqual(
+            "something",
+            csharp_factories._generate._generate_text(
+                len_constraint=None, pattern_constraints=[]
+            ),
+        )
+
+    def test_len(self) -> None:
+        # pylint: disable=protected-access
+        self.assertEqual(
+            "som",
+            csharp_factories._generate._generate_text(
+                len_constraint=infer_for_schema.LenConstraint(
+                    min_value=None, max_value=3
+                ),
+                pattern_constraints=[],
+            ),
+        )
+
+        self.assertEqual(
+            "somethingxx",
+            csharp_factories._generate._generate_text(
+                len_constraint=infer_for_schema.LenConstraint(
+                    min_value=11, max_value=None
+                ),
+                pattern_constraints=[],
+            ),
+        )
+
+    def test_patterns_and_len(self) -> None:
+        patterns = ["^[a-zA-Z][a-zA-Z0-9+.-]*:.*$", "^[^0-9]{4,}$"]
+
+        # pylint: disable=protected-access
+        got = csharp_factories._generate._generate_text(
+            len_constraint=infer_for_schema.LenConstraint(min_value=6, max_value=8),
+            pattern_constraints=[
+                infer_for_schema.PatternConstraint(pattern=pattern)
+                for pattern in patterns
+            ],
+        )
+
+        assert got is not None
+        self.assertTrue(6 <= len(got) <= 8, got)
+        for pattern in patterns:
+            self.assertIsNotNone(re.match(pattern, got), (pattern, got))
+
+    def test_unsatisfiable(self) -> None:
+        # pylint: disable=protected-access
+        self.assertIsNone(
+            csharp_factories._generate._generate_text(
+                len_constraint=None,
+                pattern_constraints=[
+                    infer_for_schema.PatternConstraint(pattern="^a+$"),
+                    infer_for_schema.PatternConstraint(pattern="^b+$"),
+                ],
+            )
+        )
+
+
+class Test_generate(unittest.TestCase):
+    def test_inferred_constraints(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            def matches_xs_date(text: str) -> bool:
+                pattern = f"^[0-9]{{4}}-[0-9]{{2}}-[0-9]{{2}}$"
+                return match(pattern, text) is not None
+
+
+            @invariant(lambda self: matches_xs_date(self), "Date")
+            class Date(str):
+                pass
+
+
+            class Kind(Enum):
+                Template = "Template"
+                Instance = "Instance"
+
+
+            Instance_kinds: Set[Kind] = constant_set(
+                values=[
+                    Kind.Instance
+                ])
+
+
+            @invariant(lambda self: len(self.name) >= 12, "Name long enough")
+            @invariant(lambda self: self.kind in Instance_kinds, "Only instances")
+            class Something:
+                name: str
+                date: Date
+                kind: Kind
+
+                def __init__(self, name: str, date: Date, kind: Kind) -> None:
+                    self.name = name
+                    self.date = date
+                    self.kind = kind
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_factories.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("Something"),
+            spec_impls=dict(),
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertIn(
+            textwrap.indent(
+                textwrap.dedent(
+                    """\
+                    return new Aas.Something(
+                        name: "somethingxxx",
+                        date: "0000-00-00",
+                        kind: Aas.Kind.Instance);"""
+                ),
+                csharp_common.INDENT3,
+            ),
+            code,
+        )
+
+    def test_snippet_takes_precedence(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @invariant(lambda self: len(self) <= 3, "Short")
+            class Short_string(str):
+                pass
+
+
+            class Something:
+                label: Short_string
+
+                def __init__(self, label: Short_string) -> None:
+                    self.label = label
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_factories.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("Something"),
+            spec_impls={
+                specific_implementations.ImplementationKey(
+                    "Minimal/Short_string.cs"
+                ): Stripped('"abc"')
+            },
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertIn('label: "abc");', code)
+
+    def test_unsatisfiable(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            def is_a(text: str) -> bool:
+                pattern = f"^a+$"
+                return match(pattern, text) is not None
+
+
+            @verification
+            def is_b(text: str) -> bool:
+                pattern = f"^b+$"
+                return match(pattern, text) is not None
+
+
+            @invariant(lambda self: is_b(self), "B")
+            @invariant(lambda self: is_a(self), "A")
+            class Impossible(str):
+                pass
+
+
+            class Something:
+                impossible: Impossible
+
+                def __init__(self, impossible: Impossible) -> None:
+                    self.impossible = impossible
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_factories.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("Something"),
+            spec_impls=dict(),
+        )
+        assert code is None
+        assert errors is not None
+
+        self.assertEqual(
+            "We could not infer a value for the constrained primitive 'Impossible' "
+            "which satisfies its constraints. Please supply the value with "
+            "the snippet 'Minimal/Impossible.cs'",
+            tests.common.most_underlying_messages(errors),
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -10,6 +10,7 @@ import unittest
 import aas_core_meta.v3rc2
 
 import aas_core_codegen.main
+from aas_core_codegen import run
 
 import tests.common
 
@@ -55,6 +56,15 @@ class Test_against_recorded(unittest.TestCase):
                     target=aas_core_codegen.main.Target.CSHARP,
                     snippets_dir=snippets_dir,
                     output_dir=output_dir,
+                    # The snippets delegate to the IRI validation. The other extras
+                    # do not depend on the meta-model, so we record them as well.
+                    extras={
+                        run.Extra.IRI_VALIDATION,
+                        run.Extra.SIGNING,
+                        run.Extra.CANONICALIZATION,
+                        run.Extra.LANGUAGE_TAGS,
+                        run.Extra.JSON_LINES,
+                    },
                 )
 
                 stdout = io.StringIO()
@@ -99,6 +109,11 @@ class Test_against_recorded(unittest.TestCase):
                     pathlib.Path("stringification.cs"),
                     pathlib.Path("jsonization.cs"),
                     pathlib.Path("xmlization.cs"),
+                    pathlib.Path("iri_validation.cs"),
+                    pathlib.Path("signing.cs"),
+                    pathlib.Path("canonicalization.cs"),
+                    pathlib.Path("language_tags.cs"),
+                    pathlib.Path("json_lines.cs"),
                 ]:
                     expected_pth = expected_output_dir / relevant_rel_pth
                     output_pth = output_dir / relevant_rel_pth
@@ -0,0 +1,91 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import io
+import os
+import pathlib
+import tempfile
+import textwrap
+import unittest
+
+import aas_core_codegen.main
+from aas_core_codegen.csharp import main as csharp_main
+
+
+class Test_strip_doc_comments(unittest.TestCase):
+    def test_doc_comments_are_removed(self) -> None:
+        code = textwrap.dedent(
+            """\
+            /// <summary>
+            /// Something
+            /// </summary>
+            public class Something
+            {
+                /// <summary>Some property</summary>
+                // A regular comment
+                public string Text = "/// not a comment";
+            }
+            """
+        )
+
+        self.assertEqual(
+            textwrap.dedent(
+                """\
+                public class Something
+                {
+                    // A regular comment
+                    public string Text = "/// not a comment";
+                }
+                """
+            ),
+            csharp_main._strip_doc_comments(code),
+        )
+
+
+class Test_optimize_for_size(unittest.TestCase):
+    def test_only_the_generated_files_are_stripped(self) -> None:
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        case_dir = repo_dir / "test_data" / "csharp" / "test_extras" / "small_model"
+
+        hand_written = "/// <summary>Written by hand</summary>\npublic class Hand {}\n"
+
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            output_dir = pathlib.Path(tmp_dir)
+            (output_dir / "Hand.cs").write_text(hand_written, encoding="utf-8")
+
+            params = aas_core_codegen.main.Parameters(
+                model_path=case_dir / "input/model.py",
+                target=aas_core_codegen.main.Target.CSHARP,
+                snippets_dir=case_dir / "input/snippets",
+                output_dir=output_dir,
+                optimize_for_size=True,
+            )
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+
+            return_code = aas_core_codegen.main.execute(
+                params=params, stdout=stdout, stderr=stderr
+            )
+
+            self.assertEqual("", stderr.getvalue())
+            self.assertEqual(0, return_code)
+
+            self.assertEqual(
+                hand_written, (output_dir / "Hand.cs").read_text(encoding="utf-8")
+            )
+
+            generated_pths = sorted(
+                pth for pth in output_dir.glob("*.cs") if pth.name != "Hand.cs"
+            )
+            self.assertIn(output_dir / "types.cs", generated_pths)
+
+            for pth in generated_pths:
+                for line in pth.read_text(encoding="utf-8").splitlines():
+                    self.assertFalse(line.lstrip().startswith("///"), pth)
+
+
+if __name__ == "__main__":
+    unittest.main()
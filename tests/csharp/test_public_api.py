@@ -0,0 +1,101 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+from typing import List
+
+import tests.common
+from aas_core_codegen.csharp import public_api as csharp_public_api
+
+
+def collect(source: str) -> csharp_public_api.PublicApi:
+    symbol_table, error = tests.common.translate_source_to_intermediate(source=source)
+    assert error is None, tests.common.most_underlying_messages(error)
+    assert symbol_table is not None
+
+    return csharp_public_api.collect(symbol_table=symbol_table)
+
+
+def render(changes: List[csharp_public_api.Change]) -> List[str]:
+    return [str(change) for change in changes]
+
+
+class Test_collect(unittest.TestCase):
+    def test_class(self) -> None:
+        public_api = collect(
+            textwrap.dedent(
+                """\
+                class Something:
+                    some_property: Optional[str]
+
+                    def __init__(self, some_property: Optional[str] = None) -> None:
+                        self.some_property = some_property
+
+
+                __book_url__ = "dummy"
+                __book_version__ = "dummy"
+                """
+            )
+        )
+
+        self.assertDictEqual(
+            {
+                "Something": "class",
+                "Something.SomeProperty": "string?",
+                "Something.Something": "void (string? someProperty)",
+            },
+            dict(public_api),
+        )
+
+
+class Test_compare(unittest.TestCase):
+    def test_no_changes(self) -> None:
+        public_api = {"Something": "class", "Something.SomeProperty": "string?"}
+        self.assertListEqual([], csharp_public_api.compare(public_api, public_api))
+
+    def test_changes(self) -> None:
+        old = {
+            "Something": "class",
+            "Something.SomeProperty": "string?",
+            "Something.OtherProperty": "int",
+            "Something.ThirdProperty": "bool",
+        }
+        new = {
+            "Something": "class",
+            "Something.RenamedProperty": "string?",
+            "Something.OtherProperty": "long",
+            "Something.FourthProperty": "byte[]",
+        }
+
+        changes = csharp_public_api.compare(old, new)
+
+        self.assertListEqual(
+            [
+                "removed: Something.ThirdProperty: bool",
+                "renamed: Something.SomeProperty -> Something.RenamedProperty",
+                "changed: Something.OtherProperty: int -> long",
+                "added: Something.FourthProperty: byte[]",
+            ],
+            render(changes),
+        )
+
+        self.assertListEqual(
+            [True, True, True, False], [change.is_breaking() for change in changes]
+        )
+
+    def test_dump_and_load(self) -> None:
+        public_api = {"Something": "class", "Something.SomeProperty": "string?"}
+        self.assertDictEqual(
+            public_api,
+            dict(
+                csharp_public_api.load(  # type: ignore
+                    csharp_public_api.dump(public_api)
+                )
+            ),
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
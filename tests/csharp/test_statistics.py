@@ -0,0 +1,92 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+
+import tests.common
+from aas_core_codegen import intermediate
+from aas_core_codegen.common import Identifier
+from aas_core_codegen.csharp import statistics as csharp_statistics
+
+
+class Test_visit_for_class(unittest.TestCase):
+    def test_lists_of_primitives(self) -> None:
+        source = textwrap.dedent(
+            """\
+            class Something:
+                some_str: str
+                some_bytes: Optional[bytearray]
+
+                def __init__(
+                    self, some_str: str, some_bytes: Optional[bytearray] = None
+                ) -> None:
+                    self.some_str = some_str
+                    self.some_bytes = some_bytes
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        cls = symbol_table.must_find_concrete_class(Identifier("Something"))
+
+        # The intermediate layer does not allow lists of primitives at
+        # the moment, so we wrap the type annotations in lists manually.
+        some_str = cls.properties_by_name[Identifier("some_str")]
+        some_str.type_annotation = intermediate.ListTypeAnnotation(
+            items=some_str.type_annotation, parsed=some_str.parsed.type_annotation
+        )
+
+        some_bytes = cls.properties_by_name[Identifier("some_bytes")]
+        assert isinstance(
+            some_bytes.type_annotation, intermediate.OptionalTypeAnnotation
+        )
+        some_bytes.type_annotation = intermediate.OptionalTypeAnnotation(
+            value=intermediate.ListTypeAnnotation(
+                items=some_bytes.type_annotation.value,
+                parsed=some_bytes.parsed.type_annotation,
+            ),
+            parsed=some_bytes.parsed.type_annotation,
+        )
+
+        # pylint: disable=protected-access
+        got = csharp_statistics._generate._generate_visit_for_class(cls=cls)
+
+        self.assertEqual(
+            textwrap.dedent(
+                """\
+                public override void Visit(Aas.Something that)
+                {
+                    _stats.CountInstance("Something");
+
+                    foreach (var item in that.SomeStr)
+                    {
+                        _stats.StringPayload += item.Length;
+                    }
+
+                    if (that.SomeBytes != null)
+                    {
+                        foreach (var item in that.SomeBytes)
+                        {
+                            _stats.BytePayload += item.Length;
+                        }
+                    }
+
+                    base.Visit(that);
+                }"""
+            ),
+            got,
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,86 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+
+import tests.common
+from aas_core_codegen.csharp import (
+    common as csharp_common,
+    stringification as csharp_stringification,
+)
+
+
+class Test_lenient(unittest.TestCase):
+    def test_ambiguous_aliases_are_left_out(self) -> None:
+        source = textwrap.dedent(
+            """\
+            class Something(Enum):
+                Some_literal = "some-literal"
+                Another_literal = "SOME_LITERAL"
+                Third_literal = "third"
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_stringification.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            lenient=True,
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        lenient_code = code[code.index("SomethingFromStringLenient") :]
+
+        self.assertNotIn('case "someliteral":', lenient_code)
+        self.assertIn('case "anotherliteral":', lenient_code)
+        self.assertIn('case "third":', lenient_code)
+        self.assertIn('case "thirdliteral":', lenient_code)
+
+    def test_only_ascii_is_lower_cased(self) -> None:
+        source = textwrap.dedent(
+            """\
+            class Something(Enum):
+                Anger = "ÄRGER"
+                Dotted = "İD"
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        code, errors = csharp_stringification.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            lenient=True,
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        lenient_code = code[code.index("SomethingFromStringLenient") :]
+
+        self.assertIn('case "Ärger":', lenient_code)
+        self.assertNotIn('case "ärger":', lenient_code)
+        self.assertIn('case "İd":', lenient_code)
+
+
+if __name__ == "__main__":
+    unittest.main()
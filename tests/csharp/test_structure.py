@@ -1,10 +1,12 @@
 # pylint: disable=missing-docstring
 import os
 import pathlib
+import textwrap
 import unittest
+from typing import Optional, Tuple
 
-from aas_core_codegen import intermediate
-from aas_core_codegen.common import LinenoColumner, Error
+from aas_core_codegen import intermediate, specific_implementations
+from aas_core_codegen.common import LinenoColumner, Error, Stripped
 from aas_core_codegen.csharp import (
     common as csharp_common,
     structure as csharp_structure,
@@ -89,5 +91,78 @@ class Test_generation_against_recorded(unittest.TestCase):
                 self.assertEqual(expected_code, code)
 
 
+
+class Test_placeholders(unittest.TestCase):
+    @staticmethod
+    def generate_with_snippet(snippet: str) -> Tuple[Optional[str], Optional[str]]:
+        source = textwrap.dedent(
+            """\
+            class Something:
+                some_property: str
+
+                @implementation_specific
+                def some_func(self) -> str:
+                    pass
+
+                def __init__(self, some_property: str) -> None:
+                    self.some_property = some_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        verified_symbol_table, errors = csharp_structure.verify(
+            symbol_table=symbol_table
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert verified_symbol_table is not None
+
+        code, errors = csharp_structure.generate(
+            symbol_table=verified_symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            spec_impls={
+                specific_implementations.ImplementationKey(
+                    "Types/Something/some_func.cs"
+                ): Stripped(snippet)
+            },
+        )
+
+        if errors is not None:
+            return None, tests.common.most_underlying_messages(errors)
+
+        return code, None
+
+    def test_known_placeholders(self) -> None:
+        code, error = Test_placeholders.generate_with_snippet(
+            "public string SomeFunc() => "
+            "$\"_NAMESPACE_._CLASS_NAME_._PROPERTY_SOME_PROPERTY_\";"
+        )
+
+        assert error is None, error
+        assert code is not None
+
+        self.assertIn(
+            'public string SomeFunc() => $"dummyNamespace.Something.SomeProperty";',
+            code,
+        )
+
+    def test_unknown_placeholder(self) -> None:
+        _, error = Test_placeholders.generate_with_snippet(
+            "public string SomeFunc() => _PROPERTY_ANOTHER_PROPERTY_;"
+        )
+
+        assert error is not None
+
+        self.assertIn("unknown placeholder(s): _PROPERTY_ANOTHER_PROPERTY_", error)
+
+
 if __name__ == "__main__":
     unittest.main()
@@ -1,10 +1,12 @@
 # pylint: disable=missing-docstring
 import os
 import pathlib
+import textwrap
 import unittest
+from typing import List, Optional
 
-from aas_core_codegen import intermediate
-from aas_core_codegen.common import LinenoColumner, Error
+from aas_core_codegen import intermediate, specific_implementations
+from aas_core_codegen.common import LinenoColumner, Error, Stripped
 from aas_core_codegen.csharp import (
     common as csharp_common,
     verification as csharp_verification,
@@ -101,6 +103,182 @@ class Test_wrap_invariant_description(unittest.TestCase):
         self.assertListEqual(expected, got)
 
 
+class Test_parse_public_static_methods(unittest.TestCase):
+    def test_no_methods(self) -> None:
+        got = csharp_verification._generate._parse_public_static_methods(
+            "private static bool Something(int x) { return true; }"
+        )
+
+        self.assertDictEqual({}, dict(got))
+
+    def test_generic_parameters(self) -> None:
+        code = """\
+public static bool Something(
+    Dictionary<string, int> mapping,
+    IEnumerable<Aas.IReferable>? referables = null
+)
+{
+    return true;
+}
+
+public static bool Nothing()
+{
+    return true;
+}"""
+
+        got = csharp_verification._generate._parse_public_static_methods(code)
+
+        self.assertDictEqual(
+            {
+                "Something": [
+                    ("Dictionary<string,int>", "mapping"),
+                    ("IEnumerable<Aas.IReferable>?", "referables"),
+                ],
+                "Nothing": [],
+            },
+            {
+                name: [
+                    (parameter.type_code, parameter.name) for parameter in parameters
+                ]
+                for name, parameters in got.items()
+            },
+        )
+
+
+class Test_snippet_signature(unittest.TestCase):
+    @staticmethod
+    def verify_with_snippet(snippet: str) -> Optional[List[str]]:
+        source = textwrap.dedent(
+            """\
+            @verification
+            @implementation_specific
+            def is_something(text: str, limit: int) -> bool:
+                pass
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        spec_impls = {
+            specific_implementations.ImplementationKey(
+                "Verification/is_something.cs"
+            ): Stripped(snippet)
+        }
+
+        return csharp_verification.verify(
+            spec_impls=spec_impls,
+            verification_functions=symbol_table.verification_functions,
+        )
+
+    def test_ok(self) -> None:
+        errors = Test_snippet_signature.verify_with_snippet(
+            "public static bool IsSomething(string text, long limit) { return true; }"
+        )
+
+        self.assertIsNone(errors)
+
+    def test_different_arity(self) -> None:
+        errors = Test_snippet_signature.verify_with_snippet(
+            "public static bool IsSomething(string text) { return true; }"
+        )
+
+        assert errors is not None
+        self.assertEqual(1, len(errors))
+        self.assertIn("is expected to have 2 parameter(s)", errors[0])
+
+    def test_swapped_names(self) -> None:
+        errors = Test_snippet_signature.verify_with_snippet(
+            "public static bool IsSomething(long limit, string text) { return true; }"
+        )
+
+        assert errors is not None
+        self.assertEqual(1, len(errors))
+        self.assertIn(
+            "is expected to have the parameters (text, limit), "
+            "but it has (limit, text)",
+            errors[0],
+        )
+
+    def test_different_type(self) -> None:
+        errors = Test_snippet_signature.verify_with_snippet(
+            "public static bool IsSomething(string text, int limit) { return true; }"
+        )
+
+        assert errors is not None
+        self.assertEqual(1, len(errors))
+        self.assertIn(
+            "The parameter limit of the method IsSomething "
+            "in the implementation snippet Verification/is_something.cs "
+            "is expected to be of type long, but it is int",
+            errors[0],
+        )
+
+    def test_enumerable_of_our_type(self) -> None:
+        source = textwrap.dedent(
+            """\
+            class Something:
+                some_str: str
+
+                def __init__(self, some_str: str) -> None:
+                    self.some_str = some_str
+
+
+            @verification
+            @implementation_specific
+            def are_unique(somethings: List[Something]) -> bool:
+                pass
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        spec_impls = {
+            specific_implementations.ImplementationKey(
+                "Verification/are_unique.cs"
+            ): Stripped(
+                "public static bool AreUnique("
+                "IEnumerable<Aas.Something> somethings) { return true; }"
+            )
+        }
+
+        errors = csharp_verification.verify(
+            spec_impls=spec_impls,
+            verification_functions=symbol_table.verification_functions,
+        )
+
+        self.assertIsNone(errors)
+
+    def test_unknown_placeholder(self) -> None:
+        errors = Test_snippet_signature.verify_with_snippet(
+            "public static bool IsSomething(string text, long limit) "
+            "{ return text.Length < _MAX_LENGTH_; }"
+        )
+
+        assert errors is not None
+        self.assertEqual(1, len(errors))
+        self.assertIn(
+            "The implementation snippet Verification/is_something.cs uses "
+            "unknown placeholder(s): _MAX_LENGTH_",
+            errors[0],
+        )
+
+
 class Test_pattern_translation_against_recorded(unittest.TestCase):
     def test_cases(self) -> None:
         repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
@@ -167,5 +345,111 @@ class Test_pattern_translation_against_recorded(unittest.TestCase):
                 self.assertEqual(expected_code, code)
 
 
+
+class Test_shared_snippet(unittest.TestCase):
+    def test_transpiled_if_no_csharp_snippet(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            @implementation_specific
+            def is_something(text: str) -> bool:
+                pass
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        shared_snippet = textwrap.dedent(
+            """\
+            def is_something(text: str) -> bool:
+                \"\"\"Check that the text is not empty.\"\"\"
+                return len(text) > 0
+            """
+        )
+
+        spec_impls = {
+            specific_implementations.ImplementationKey(
+                "Verification/is_something.py"
+            ): Stripped(shared_snippet)
+        }
+
+        verify_errors = csharp_verification.verify(
+            spec_impls=spec_impls,
+            verification_functions=symbol_table.verification_functions,
+        )
+        self.assertIsNone(verify_errors)
+
+        code, errors = csharp_verification.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            spec_impls=spec_impls,
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertIn("public static bool IsSomething(", code)
+
+
+class Test_pattern_snippet(unittest.TestCase):
+    def test_snippet_replaces_regex(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            def matches_something(text: str) -> bool:
+                pattern = "^[a-z]+$"
+                return match(pattern, text) is not None
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        snippet = textwrap.dedent(
+            """\
+            public static bool MatchesSomething(string text)
+            {
+                return text.Length > 0 && text.All(char.IsLower);
+            }"""
+        )
+
+        spec_impls = {
+            specific_implementations.ImplementationKey(
+                "Verification/matches_something.cs"
+            ): Stripped(snippet)
+        }
+
+        verify_errors = csharp_verification.verify(
+            spec_impls=spec_impls,
+            verification_functions=symbol_table.verification_functions,
+        )
+        self.assertIsNone(verify_errors)
+
+        code, errors = csharp_verification.generate(
+            symbol_table=symbol_table,
+            namespace=csharp_common.NamespaceIdentifier("dummyNamespace"),
+            spec_impls=spec_impls,
+        )
+        assert errors is None, tests.common.most_underlying_messages(errors)
+        assert code is not None
+
+        self.assertIn("text.All(char.IsLower)", code)
+        self.assertNotIn("_constructMatchesSomething", code)
+
+
 if __name__ == "__main__":
     unittest.main()
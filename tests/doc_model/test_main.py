@@ -0,0 +1,78 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import textwrap
+import unittest
+
+import tests.constraints_report.test_main
+from aas_core_codegen import intermediate
+from aas_core_codegen.doc_model import main as doc_model_main
+
+
+class Test_render_pseudocode(unittest.TestCase):
+    def test_invariants(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @invariant(lambda self: len(self) > 0)
+            class Non_empty_string(str):
+                pass
+
+
+            class Item:
+                text: str
+
+                def __init__(self, text: str) -> None:
+                    self.text = text
+
+
+            @invariant(
+                lambda self:
+                not (self.some_property is not None)
+                or all(len(item.text) <= 3 for item in self.some_property)
+            )
+            @invariant(
+                lambda self:
+                self.other_property is not None
+                and (self.other_property == "a" or self.other_property == "b")
+            )
+            class Something:
+                some_property: Optional[List[Item]]
+                other_property: Optional[str]
+
+                def __init__(
+                    self,
+                    some_property: Optional[List[Item]] = None,
+                    other_property: Optional[str] = None
+                ) -> None:
+                    self.some_property = some_property
+                    self.other_property = other_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        symbol_table, _ = tests.constraints_report.test_main.translate(source)
+
+        # pylint: disable=protected-access
+        self.assertListEqual(
+            [
+                "length of self > 0",
+                "other_property is specified "
+                'and (other_property = "a" or other_property = "b")',
+                "if some_property is specified, "
+                "then (for every item in some_property: length of item.text ≤ 3)",
+            ],
+            [
+                doc_model_main._render_pseudocode(invariant.body)
+                for our_type in symbol_table.our_types
+                if not isinstance(our_type, intermediate.Enumeration)
+                for invariant in our_type.invariants
+            ],
+        )
+
+
+if __name__ == "__main__":
+    unittest.main()
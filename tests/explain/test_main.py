@@ -0,0 +1,90 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import io
+import pathlib
+import tempfile
+import textwrap
+import unittest
+
+from aas_core_codegen.explain import main as explain_main
+
+_SOURCE = textwrap.dedent(
+    """\
+    @verification
+    @implementation_specific
+    def is_fancy(text: str) -> bool:
+        pass
+
+
+    @abstract
+    @invariant(
+        lambda self: is_fancy(self.some_property),
+        "Constraint AASd-001: The property must be fancy."
+    )
+    class Parent:
+        some_property: str
+
+        def __init__(self, some_property: str) -> None:
+            self.some_property = some_property
+
+
+    class Something(Parent):
+        def __init__(self, some_property: str) -> None:
+            Parent.__init__(self, some_property)
+
+
+    __book_url__ = "dummy"
+    __book_version__ = "dummy"
+    """
+)
+
+
+class Test_execute(unittest.TestCase):
+    def test_explains_the_constraint(self) -> None:
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            model_path = pathlib.Path(tmp_dir) / "meta_model.py"
+            model_path.write_text(_SOURCE, encoding="utf-8")
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+            return_code = explain_main.execute(
+                model_path=model_path,
+                identifier="AASd-001",
+                stdout=stdout,
+                stderr=stderr,
+            )
+
+            self.assertEqual(0, return_code, stderr.getvalue())
+
+            output = stdout.getvalue()
+            self.assertIn("Invariant specified for Parent:", output)
+            self.assertIn("Applies to: Parent, Something", output)
+            self.assertIn("csharp: snippet (Verification/is_fancy.cs)", output)
+            self.assertIn("jsonschema: dropped-per-schema", output)
+            self.assertIn(
+                "csharp: verification.cs "
+                "Verification.Transformer.Transform(Aas.Something)",
+                output,
+            )
+
+    def test_unknown_constraint(self) -> None:
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            model_path = pathlib.Path(tmp_dir) / "meta_model.py"
+            model_path.write_text(_SOURCE, encoding="utf-8")
+
+            stderr = io.StringIO()
+            return_code = explain_main.execute(
+                model_path=model_path,
+                identifier="AASd-999",
+                stdout=io.StringIO(),
+                stderr=stderr,
+            )
+
+            self.assertEqual(1, return_code)
+            self.assertIn("AASd-999", stderr.getvalue())
+
+
+if __name__ == "__main__":
+    unittest.main()
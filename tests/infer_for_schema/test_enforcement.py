@@ -0,0 +1,98 @@
+# pylint: disable=missing-docstring
+
+import textwrap
+import unittest
+from typing import List
+
+import tests.infer_for_schema.common
+from aas_core_codegen import infer_for_schema
+
+
+def enforced_by_schema(source: str) -> List[bool]:
+    """Check for each invariant of ``Something`` whether the schemas enforce it."""
+    (
+        symbol_table,
+        something_cls,
+    ) = tests.infer_for_schema.common.parse_to_symbol_table_and_something_cls(
+        source=source
+    )
+
+    pattern_verifications_by_name = infer_for_schema.map_pattern_verifications_by_name(
+        verifications=symbol_table.verification_functions
+    )
+
+    return [
+        infer_for_schema.is_enforced_by_schema(
+            invariant=invariant,
+            pattern_verifications_by_name=pattern_verifications_by_name,
+        )
+        for invariant in something_cls.invariants
+    ]
+
+
+class Test_is_enforced_by_schema(unittest.TestCase):
+    def test_len_and_pattern(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @verification
+            def matches_something(text: str) -> bool:
+                return match("something-[a-zA-Z]+", text) is not None
+
+
+            @invariant(lambda self: len(self.some_property) > 0)
+            @invariant(
+                lambda self:
+                not (self.another_property is not None)
+                or matches_something(self.another_property)
+            )
+            class Something:
+                some_property: str
+                another_property: Optional[str]
+
+                def __init__(
+                    self,
+                    some_property: str,
+                    another_property: Optional[str] = None
+                ) -> None:
+                    self.some_property = some_property
+                    self.another_property = another_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        self.assertListEqual([True, True], enforced_by_schema(source))
+
+    def test_dropped(self) -> None:
+        source = textwrap.dedent(
+            """\
+            @invariant(lambda self: len(self.some_property) != 3)
+            @invariant(
+                lambda self:
+                self.some_property != self.another_property
+            )
+            class Something:
+                some_property: str
+                another_property: str
+
+                def __init__(
+                    self,
+                    some_property: str,
+                    another_property: str
+                ) -> None:
+                    self.some_property = some_property
+                    self.another_property = another_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        self.assertListEqual([False, False], enforced_by_schema(source))
+
+
+if __name__ == "__main__":
+    unittest.main()
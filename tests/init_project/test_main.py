@@ -0,0 +1,53 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import ast
+import io
+import pathlib
+import tempfile
+import unittest
+
+import aas_core_codegen.main
+from aas_core_codegen.init_project import main as init_project_main
+
+
+class Test_execute(unittest.TestCase):
+    def test_scaffolds_and_refuses_to_overwrite(self) -> None:
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            output_dir = pathlib.Path(tmp_dir) / "sdk"
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+            return_code = init_project_main.execute(
+                target=aas_core_codegen.main.Target.CSHARP,
+                output_dir=output_dir,
+                stdout=stdout,
+                stderr=stderr,
+            )
+
+            self.assertEqual(0, return_code, stderr.getvalue())
+
+            for relative_pth in init_project_main.scaffold(
+                target=aas_core_codegen.main.Target.CSHARP
+            ):
+                self.assertTrue((output_dir / relative_pth).is_file(), relative_pth)
+
+            generate_py = (output_dir / "generate.py").read_text(encoding="utf-8")
+            ast.parse(generate_py)
+            self.assertIn('"csharp"', generate_py)
+
+            stderr = io.StringIO()
+            return_code = init_project_main.execute(
+                target=aas_core_codegen.main.Target.CSHARP,
+                output_dir=output_dir,
+                stdout=io.StringIO(),
+                stderr=stderr,
+            )
+
+            self.assertEqual(1, return_code)
+            self.assertIn("Refusing to overwrite", stderr.getvalue())
+
+
+if __name__ == "__main__":
+    unittest.main()
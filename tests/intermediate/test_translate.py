@@ -150,6 +150,76 @@ class Test_parsing_docstrings(unittest.TestCase):
             ["AAS-001"], list(some_class.description.constraints_by_identifier.keys())
         )
 
+    def test_renamed_from(self) -> None:
+        source = textwrap.dedent(
+            '''\
+            class Some_class:
+                """
+                Represent something.
+
+                :renamed_from: Old_class
+                """
+
+                some_property: int
+                """
+                Represent some property.
+
+                :renamed_from: old_property
+                """
+
+                def __init__(self, some_property: int) -> None:
+                    self.some_property = some_property
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            '''
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+
+        assert symbol_table is not None
+
+        some_class = symbol_table.must_find_class(Identifier("Some_class"))
+
+        assert some_class.description is not None
+        self.assertEqual("Old_class", some_class.description.renamed_from)
+
+        some_property = some_class.properties_by_name[Identifier("some_property")]
+
+        assert some_property.description is not None
+        self.assertEqual("old_property", some_property.description.renamed_from)
+
+    def test_since(self) -> None:
+        source = textwrap.dedent(
+            '''\
+            class Some_class:
+                """
+                Represent something.
+
+                :since: V3.0
+                """
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            '''
+        )
+
+        symbol_table, error = tests.common.translate_source_to_intermediate(
+            source=source
+        )
+        assert error is None, tests.common.most_underlying_messages(error)
+
+        assert symbol_table is not None
+
+        some_class = symbol_table.must_find_class(Identifier("Some_class"))
+
+        assert some_class.description is not None
+        self.assertEqual("V3.0", some_class.description.since)
+
 
 class Test_against_recorded(unittest.TestCase):
     def test_cases(self) -> None:
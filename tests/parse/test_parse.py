@@ -7,6 +7,7 @@ import ast
 import os
 import pathlib
 import re
+import sys
 import textwrap
 import unittest
 from typing import Optional, Tuple, List
@@ -223,6 +224,115 @@ class Test_unexpected_class_definitions(unittest.TestCase):
         return error
 
 
+@unittest.skipIf(
+    sys.version_info < (3, 12), "The type statement is available from Python 3.12 on"
+)
+class Test_type_alias(unittest.TestCase):
+    def test_aliases_substituted(self) -> None:
+        source = textwrap.dedent(
+            """\
+            type Text = str
+            type Texts = List[Text] | None
+
+
+            class Something:
+                some_str: Text | None
+                some_texts: Texts
+
+                def __init__(
+                    self, some_str: Text | None = None, some_texts: Texts = None
+                ) -> None:
+                    self.some_str = some_str
+                    self.some_texts = some_texts
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        atok, parse_exception = parse.source_to_atok(source=source)
+        assert parse_exception is None, f"{parse_exception=}"
+        assert atok is not None
+
+        symbol_table, error = parse.atok_to_symbol_table(atok=atok)
+        assert error is None, tests.common.most_underlying_messages(error)
+        assert symbol_table is not None
+
+        cls = symbol_table.must_find_class(Identifier("Something"))
+
+        self.assertListEqual(
+            ["Optional[str]", "Optional[List[str]]"],
+            [str(prop.type_annotation) for prop in cls.properties],
+        )
+
+    def test_cycle(self) -> None:
+        source = textwrap.dedent(
+            """\
+            type A = B
+            type B = A
+
+
+            __book_url__ = "dummy"
+            __book_version__ = "dummy"
+            """
+        )
+
+        atok, parse_exception = parse.source_to_atok(source=source)
+        assert parse_exception is None, f"{parse_exception=}"
+        assert atok is not None
+
+        _, error = parse.atok_to_symbol_table(atok=atok)
+        assert error is not None
+
+        self.assertEqual(
+            "The type aliases are defined in a cycle: A -> B -> A",
+            tests.common.most_underlying_messages(error),
+        )
+
+
+class Test_type_alias_substitution(unittest.TestCase):
+    def test_substituted_nodes_not_shared(self) -> None:
+        tree = ast.parse(
+            textwrap.dedent(
+                """\
+                class Something:
+                    some_texts: Texts
+                    another_texts: Texts
+                """
+            )
+        )
+
+        value = ast.parse("List[str]", mode="eval").body
+
+        # pylint: disable=protected-access
+        substituter = parse._translate._TypeAliasSubstituter(
+            {Identifier("Texts"): value}
+        )
+        substituter.visit(tree)
+
+        cls_def = tree.body[0]
+        assert isinstance(cls_def, ast.ClassDef)
+
+        annotations = [
+            stmt.annotation for stmt in cls_def.body if isinstance(stmt, ast.AnnAssign)
+        ]
+        self.assertListEqual(
+            [ast.dump(value), ast.dump(value)],
+            [ast.dump(annotation) for annotation in annotations],
+        )
+
+        # The contexts such as ``ast.Load`` are singletons, so we skip them.
+        nodes = [
+            node
+            for annotation in annotations
+            for node in ast.walk(annotation)
+            if not isinstance(node, ast.expr_context)
+        ]
+        self.assertEqual(len(nodes), len(set(id(node) for node in nodes)))
+        self.assertNotIn(id(value), set(id(node) for node in nodes))
+
+
 class Test_parse_type_annotation(unittest.TestCase):
     @staticmethod
     def parse_type_annotation_from_ann_assign(
@@ -282,8 +392,46 @@ class Test_parse_type_annotation(unittest.TestCase):
 
         self.assertEqual("Optional[List[Reference]]", str(type_annotation))
 
+    def test_optional_as_union_with_none(self) -> None:
+        for source in ["x: List[int] | None", "x: None | List[int]"]:
+            (
+                anno,
+                atok,
+            ) = Test_parse_type_annotation.parse_type_annotation_from_ann_assign(source)
+
+            type_annotation, error = parse._translate._type_annotation(
+                node=anno, atok=atok
+            )
+            assert error is None, tests.common.most_underlying_messages(error)
+
+            self.assertEqual("Optional[List[int]]", str(type_annotation), source)
+
+    def test_union_with_none_in_a_subscript(self) -> None:
+        anno, atok = Test_parse_type_annotation.parse_type_annotation_from_ann_assign(
+            "x: List[Reference | None]"
+        )
+
+        type_annotation, error = parse._translate._type_annotation(node=anno, atok=atok)
+        assert error is None, tests.common.most_underlying_messages(error)
+
+        self.assertEqual("List[Optional[Reference]]", str(type_annotation))
+
 
 class Test_parse_type_annotation_fail(unittest.TestCase):
+    def test_union_without_none(self) -> None:
+        anno, atok = Test_parse_type_annotation.parse_type_annotation_from_ann_assign(
+            "x: int | str"
+        )
+
+        _, error = parse._translate._type_annotation(node=anno, atok=atok)
+        assert error is not None
+
+        self.assertEqual(
+            "Expected a union of a type and None "
+            "to define an optional type annotation, but got: int | str",
+            error.message,
+        )
+
     def test_ellipsis(self) -> None:
         anno, atok = Test_parse_type_annotation.parse_type_annotation_from_ann_assign(
             "x: Mapping[str, ...]"
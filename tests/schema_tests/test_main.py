@@ -0,0 +1,103 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import io
+import os
+import pathlib
+import shutil
+import tempfile
+import unittest
+
+import aas_core_meta.v3rc2
+
+import aas_core_codegen.main
+from aas_core_codegen.schema_tests import main as schema_tests_main
+
+
+class Test_on_examples(unittest.TestCase):
+    def test_against_aas_core_meta(self) -> None:
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        model_pth = pathlib.Path(aas_core_meta.v3rc2.__file__)
+
+        for target in [
+            aas_core_codegen.main.Target.JSONSCHEMA,
+            aas_core_codegen.main.Target.XSD,
+        ]:
+            case_dir = (
+                repo_dir
+                / "test_data"
+                / target.value
+                / "test_main"
+                / aas_core_meta.v3rc2.__name__
+            )
+
+            with tempfile.TemporaryDirectory() as tmp_dir:
+                params = aas_core_codegen.main.Parameters(
+                    model_path=model_pth,
+                    target=target,
+                    snippets_dir=case_dir / "input" / "snippets",
+                    output_dir=pathlib.Path(tmp_dir),
+                )
+
+                stdout = io.StringIO()
+                stderr = io.StringIO()
+
+                return_code = schema_tests_main.execute(
+                    params=params,
+                    examples_dir=case_dir / "examples",
+                    stdout=stdout,
+                    stderr=stderr,
+                )
+
+                self.assertEqual(0, return_code, stderr.getvalue())
+                self.assertNotIn("and 0 unexpected", stdout.getvalue())
+
+    def test_invalid_example_fails(self) -> None:
+        repo_dir = pathlib.Path(os.path.realpath(__file__)).parent.parent.parent
+
+        model_pth = pathlib.Path(aas_core_meta.v3rc2.__file__)
+
+        case_dir = (
+            repo_dir
+            / "test_data"
+            / aas_core_codegen.main.Target.JSONSCHEMA.value
+            / "test_main"
+            / aas_core_meta.v3rc2.__name__
+        )
+
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            # We move the invalid examples among the expected ones so that
+            # the test needs to fail.
+            examples_dir = pathlib.Path(tmp_dir) / "examples"
+            shutil.copytree(
+                case_dir / "examples" / "unexpected", examples_dir / "expected"
+            )
+
+            output_dir = pathlib.Path(tmp_dir) / "output"
+            output_dir.mkdir()
+
+            params = aas_core_codegen.main.Parameters(
+                model_path=model_pth,
+                target=aas_core_codegen.main.Target.JSONSCHEMA,
+                snippets_dir=case_dir / "input" / "snippets",
+                output_dir=output_dir,
+            )
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+
+            return_code = schema_tests_main.execute(
+                params=params,
+                examples_dir=examples_dir,
+                stdout=stdout,
+                stderr=stderr,
+            )
+
+            self.assertEqual(1, return_code)
+            self.assertIn("to be valid", stderr.getvalue())
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,63 @@
+# pylint: disable=missing-module-docstring
+# pylint: disable=missing-class-docstring
+# pylint: disable=missing-function-docstring
+
+import ast
+import io
+import pathlib
+import tempfile
+import unittest
+
+from aas_core_codegen.parse import _translate as parse_translate
+from aas_core_codegen.stubs import main as stubs_main
+
+
+class Test_marker_stub(unittest.TestCase):
+    def test_covers_the_markers_expected_by_the_parser(self) -> None:
+        module = ast.parse(stubs_main.MARKER_STUB)
+
+        defined = {
+            node.name
+            for node in module.body
+            if isinstance(node, (ast.FunctionDef, ast.ClassDef))
+        }
+
+        # pylint: disable=protected-access
+        # fmt: off
+        expected = {
+            name
+            for name, module_name in (
+                parse_translate._ExpectedImportsVisitor
+                ._EXPECTED_NAME_FROM_MODULE.items()
+            )
+            if module_name == "aas_core_meta.marker"
+        }.union(
+            parse_translate._PRIMITIVE_TYPE_NAMES_TO_CONSTANT_FUNCTION_NAMES.values()
+        )
+        # fmt: on
+
+        self.assertSetEqual(set(), expected.difference(defined))
+
+
+class Test_execute(unittest.TestCase):
+    def test_writes_the_stub_package(self) -> None:
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            output_dir = pathlib.Path(tmp_dir)
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+            return_code = stubs_main.execute(
+                output_dir=output_dir, stdout=stdout, stderr=stderr
+            )
+
+            self.assertEqual(0, return_code, stderr.getvalue())
+
+            for relative_pth, content in stubs_main.STUB_FILES.items():
+                self.assertEqual(
+                    content + "\n",
+                    (output_dir / relative_pth).read_text(encoding="utf-8"),
+                )
+
+
+if __name__ == "__main__":
+    unittest.main()
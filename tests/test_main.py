@@ -0,0 +1,93 @@
+# pylint: disable=missing-docstring
+
+import io
+import os
+import pathlib
+import tempfile
+import unittest
+
+import aas_core_codegen.main
+
+REPO_DIR = pathlib.Path(os.path.realpath(__file__)).parent.parent
+
+
+class Test_compare_outputs(unittest.TestCase):
+    def test_files_from_before_the_generation_are_ignored(self) -> None:
+        with tempfile.TemporaryDirectory() as expected_tmp_dir:
+            with tempfile.TemporaryDirectory() as got_tmp_dir:
+                expected_dir = pathlib.Path(expected_tmp_dir)
+                got_dir = pathlib.Path(got_tmp_dir)
+
+                (expected_dir / "README.md").write_text("hand-written")
+                (expected_dir / "types.cs").write_text("generated")
+                (got_dir / "types.cs").write_text("generated")
+
+                errors = aas_core_codegen.main._compare_outputs(
+                    expected_dir=expected_dir,
+                    expected_written_paths=[expected_dir / "types.cs"],
+                    got_dir=got_dir,
+                )
+
+                self.assertIsNone(errors)
+
+    def test_differences(self) -> None:
+        with tempfile.TemporaryDirectory() as expected_tmp_dir:
+            with tempfile.TemporaryDirectory() as got_tmp_dir:
+                expected_dir = pathlib.Path(expected_tmp_dir)
+                got_dir = pathlib.Path(got_tmp_dir)
+
+                (expected_dir / "types.cs").write_text("generated")
+                (expected_dir / "verification.cs").write_text("generated")
+                (got_dir / "types.cs").write_text("generated differently")
+                (got_dir / "reporting.cs").write_text("generated")
+
+                errors = aas_core_codegen.main._compare_outputs(
+                    expected_dir=expected_dir,
+                    expected_written_paths=[
+                        expected_dir / "types.cs",
+                        expected_dir / "verification.cs",
+                    ],
+                    got_dir=got_dir,
+                )
+
+                self.assertListEqual(
+                    [
+                        "The file types.cs differs between the two generations",
+                        "The file verification.cs has not been generated "
+                        "the second time",
+                        "The file reporting.cs has been generated only "
+                        "the second time",
+                    ],
+                    errors,
+                )
+
+
+class Test_assert_deterministic(unittest.TestCase):
+    def test_with_a_file_from_before_the_generation(self) -> None:
+        case_dir = REPO_DIR / "test_data" / "csharp" / "test_extras" / "small_model"
+
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            output_dir = pathlib.Path(tmp_dir)
+            (output_dir / "Hand.cs").write_text("// Written by hand\n")
+
+            params = aas_core_codegen.main.Parameters(
+                model_path=case_dir / "input/model.py",
+                target=aas_core_codegen.main.Target.CSHARP,
+                snippets_dir=case_dir / "input/snippets",
+                output_dir=output_dir,
+                assert_deterministic=True,
+            )
+
+            stdout = io.StringIO()
+            stderr = io.StringIO()
+
+            return_code = aas_core_codegen.main.execute(
+                params=params, stdout=stdout, stderr=stderr
+            )
+
+            self.assertEqual("", stderr.getvalue())
+            self.assertEqual(0, return_code)
+
+
+if __name__ == "__main__":
+    unittest.main()